@@ -69,6 +69,10 @@ type Blockchain struct {
 	currentHeader     atomic.Pointer[types.Header] // The current header
 	currentDifficulty atomic.Pointer[big.Int]      // The current difficulty of the chain (total difficulty)
 
+	// firstReceipts is the number of the first block whose receipts are stored,
+	// the blocks preceding the state sync pivot are written without their receipts
+	firstReceipts atomic.Uint64
+
 	stream *eventStream // Event subscriptions
 
 	gpAverage *gasPriceAverage // A reference to the average gas price
@@ -212,6 +216,10 @@ func NewBlockchain(
 		return nil, err
 	}
 
+	if first, ok := db.ReadFirstReceiptsNumber(); ok {
+		b.firstReceipts.Store(first)
+	}
+
 	// Push the initial event to the stream
 	b.stream.push(&Event{})
 
@@ -438,6 +446,27 @@ func (b *Blockchain) GetTD(hash types.Hash) (*big.Int, bool) {
 	return b.readTotalDifficulty(hash)
 }
 
+// FirstReceiptsNumber returns the number of the first block whose receipts are stored.
+// The blocks preceding it have been written by the state sync without being executed
+func (b *Blockchain) FirstReceiptsNumber() uint64 {
+	return b.firstReceipts.Load()
+}
+
+// SetFirstReceiptsNumber records that the blocks preceding the given one are written without their receipts.
+// It must be called before such blocks are written
+func (b *Blockchain) SetFirstReceiptsNumber(number uint64) error {
+	batchWriter := storage.NewBatchWriter(b.db)
+	batchWriter.PutFirstReceiptsNumber(number)
+
+	if err := batchWriter.WriteBatch(); err != nil {
+		return err
+	}
+
+	b.firstReceipts.Store(number)
+
+	return nil
+}
+
 // GetReceiptsByHash returns the receipts by their hash
 func (b *Blockchain) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error) {
 	return b.db.ReadReceipts(hash)
@@ -618,6 +647,37 @@ func (b *Blockchain) VerifyFinalizedBlock(block *types.Block) (*types.FullBlock,
 	return &types.FullBlock{Block: block, Receipts: receipts}, nil
 }
 
+// VerifyFinalizedBlockHeader verifies the header of the finalized block and its
+// consistency with the parent and the block body, without executing the transactions.
+// It's used for the blocks preceding the synced state, as their parent state is not available
+func (b *Blockchain) VerifyFinalizedBlockHeader(block *types.Block) error {
+	if block == nil {
+		return ErrNoBlock
+	}
+
+	// Make sure the consensus layer verifies this block header
+	if err := b.consensus.VerifyHeader(block.Header); err != nil {
+		return fmt.Errorf("failed to verify the header: %w", err)
+	}
+
+	// Make sure the block is in line with the parent block
+	if err := b.verifyBlockParent(block); err != nil {
+		return err
+	}
+
+	// Make sure the Uncles root matches up
+	if hash := buildroot.CalculateUncleRoot(block.Uncles); hash != block.Header.Sha3Uncles {
+		return ErrInvalidSha3Uncles
+	}
+
+	// Make sure the transactions root matches up
+	if hash := buildroot.CalculateTransactionsRoot(block.Transactions, block.Number()); hash != block.Header.TxRoot {
+		return ErrInvalidTxRoot
+	}
+
+	return nil
+}
+
 // verifyBlock does the base (common) block verification steps by
 // verifying the block body as well as the parent information
 func (b *Blockchain) verifyBlock(block *types.Block) ([]*types.Receipt, error) {
@@ -1363,6 +1363,92 @@ func TestBlockchain_VerifyBlockBody(t *testing.T) {
 	})
 }
 
+// TestBlockchain_VerifyFinalizedBlockHeader makes sure that the block is verified without the execution
+func TestBlockchain_VerifyFinalizedBlockHeader(t *testing.T) {
+	t.Parallel()
+
+	parentHeader := &types.Header{
+		GasLimit: 5000,
+	}
+	parentHeader.ComputeHash()
+
+	newBlockchain := func(t *testing.T) *Blockchain {
+		t.Helper()
+
+		storageCallback := func(storage *storage.MockStorage) {
+			storage.HookReadHeader(func(hash types.Hash) (*types.Header, error) {
+				return parentHeader.Copy(), nil
+			})
+		}
+
+		// the execution of the transactions always fails
+		executorCallback := func(executor *mockExecutor) {
+			executor.HookProcessBlock(func(
+				hash types.Hash,
+				block *types.Block,
+				address types.Address,
+			) (*state.Transition, error) {
+				return nil, errors.New("unable to execute transactions")
+			})
+		}
+
+		blockchain, err := NewMockBlockchain(map[TestCallbackType]interface{}{
+			StorageCallback:  storageCallback,
+			ExecutorCallback: executorCallback,
+		})
+		require.NoError(t, err)
+
+		return blockchain
+	}
+
+	t.Run("Valid block", func(t *testing.T) {
+		t.Parallel()
+
+		block := &types.Block{
+			Header: &types.Header{
+				Number:     1,
+				ParentHash: parentHeader.Hash,
+				GasLimit:   parentHeader.GasLimit,
+				Sha3Uncles: types.EmptyUncleHash,
+				TxRoot:     types.EmptyRootHash,
+			},
+		}
+
+		assert.NoError(t, newBlockchain(t).VerifyFinalizedBlockHeader(block))
+	})
+
+	t.Run("Invalid block sequence", func(t *testing.T) {
+		t.Parallel()
+
+		block := &types.Block{
+			Header: &types.Header{
+				Number:     2,
+				ParentHash: parentHeader.Hash,
+				GasLimit:   parentHeader.GasLimit,
+				Sha3Uncles: types.EmptyUncleHash,
+				TxRoot:     types.EmptyRootHash,
+			},
+		}
+
+		assert.ErrorIs(t, newBlockchain(t).VerifyFinalizedBlockHeader(block), ErrInvalidBlockSequence)
+	})
+
+	t.Run("Invalid Transactions root", func(t *testing.T) {
+		t.Parallel()
+
+		block := &types.Block{
+			Header: &types.Header{
+				Number:     1,
+				ParentHash: parentHeader.Hash,
+				GasLimit:   parentHeader.GasLimit,
+				Sha3Uncles: types.EmptyUncleHash,
+			},
+		}
+
+		assert.ErrorIs(t, newBlockchain(t).VerifyFinalizedBlockHeader(block), ErrInvalidTxRoot)
+	})
+}
+
 func TestBlockchain_CalculateBaseFee(t *testing.T) {
 	t.Parallel()
 
@@ -2069,3 +2155,24 @@ func dirSize(tb testing.TB, dir string) (int64, error) {
 
 	return totalSize, nil
 }
+
+func TestBlockchain_FirstReceiptsNumber(t *testing.T) {
+	t.Parallel()
+
+	dbStorage, err := memory.NewMemoryStorage(nil)
+	require.NoError(t, err)
+
+	b, err := NewBlockchain(hclog.NewNullLogger(), dbStorage, &chain.Chain{}, nil, nil, nil)
+	require.NoError(t, err)
+
+	// the receipts of all the blocks are available unless the state sync has been used
+	require.Equal(t, uint64(0), b.FirstReceiptsNumber())
+
+	require.NoError(t, b.SetFirstReceiptsNumber(11))
+	require.Equal(t, uint64(11), b.FirstReceiptsNumber())
+
+	// the number is loaded on the restart
+	b, err = NewBlockchain(hclog.NewNullLogger(), dbStorage, &chain.Chain{}, nil, nil, nil)
+	require.NoError(t, err)
+	require.Equal(t, uint64(11), b.FirstReceiptsNumber())
+}
@@ -2,6 +2,7 @@
 package storage
 
 import (
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -46,6 +47,9 @@ var (
 	HASH   = []byte("hash")
 	NUMBER = []byte("number")
 	EMPTY  = []byte("empty")
+
+	// FIRST_RECEIPTS is the sub-prefix of the number of the first block whose receipts are stored
+	FIRST_RECEIPTS = []byte("receipts")
 )
 
 // KV is a key value storage interface.
@@ -57,6 +61,15 @@ type KV interface {
 	NewBatch() Batch
 }
 
+// IterableKV is a key value storage which can iterate over its entries
+type IterableKV interface {
+	KV
+	Iterate(prefix []byte, handler func(k, v []byte) bool) error
+}
+
+// ErrIterationNotSupported is returned when the underlying kv database can not iterate over its entries
+var ErrIterationNotSupported = errors.New("iteration is not supported by the database")
+
 // KeyValueStorage is a generic storage for kv databases
 type KeyValueStorage struct {
 	logger hclog.Logger
@@ -106,6 +119,21 @@ func (s *KeyValueStorage) ReadHeadNumber() (uint64, bool) {
 	return common.EncodeBytesToUint64(data), true
 }
 
+// ReadFirstReceiptsNumber returns the number of the first block whose receipts are stored,
+// it is missing if the receipts of all the blocks are stored
+func (s *KeyValueStorage) ReadFirstReceiptsNumber() (uint64, bool) {
+	data, ok := s.get(HEAD, FIRST_RECEIPTS)
+	if !ok {
+		return 0, false
+	}
+
+	if len(data) != 8 {
+		return 0, false
+	}
+
+	return common.EncodeBytesToUint64(data), true
+}
+
 // FORK //
 
 // ReadForks read the current forks
@@ -250,6 +278,16 @@ func (s *KeyValueStorage) Close() error {
 	return s.db.Close()
 }
 
+// Iterate iterates over the raw entries whose keys have the given prefix
+func (s *KeyValueStorage) Iterate(prefix []byte, handler func(k, v []byte) bool) error {
+	db, ok := s.db.(IterableKV)
+	if !ok {
+		return ErrIterationNotSupported
+	}
+
+	return db.Iterate(prefix, handler)
+}
+
 // NewBatch creates batch used for write/update/delete operations
 func (s *KeyValueStorage) NewBatch() Batch {
 	return s.db.NewBatch()
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/go-hclog"
 	"github.com/syndtr/goleveldb/leveldb"
 	"github.com/syndtr/goleveldb/leveldb/opt"
+	"github.com/syndtr/goleveldb/leveldb/util"
 )
 
 const (
@@ -77,6 +78,20 @@ func (l *levelDBKV) Get(p []byte) ([]byte, bool, error) {
 	return data, true, nil
 }
 
+// Iterate iterates over the key-value pairs with the given prefix in leveldb storage
+func (l *levelDBKV) Iterate(prefix []byte, handler func(k, v []byte) bool) error {
+	iter := l.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if !handler(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	return iter.Error()
+}
+
 // Close closes the leveldb storage instance
 func (l *levelDBKV) Close() error {
 	return l.db.Close()
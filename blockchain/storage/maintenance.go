@@ -0,0 +1,356 @@
+package storage
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// maintenanceBatchBlocks is the number of blocks whose entries are written in a single batch
+// by the maintenance operations
+const maintenanceBatchBlocks = 1000
+
+var (
+	// ErrHeadNotFound is returned when the storage has no head block
+	ErrHeadNotFound = errors.New("head block not found")
+	// ErrInvalidRewindTarget is returned when the rewind target is not below the head block
+	ErrInvalidRewindTarget = errors.New("rewind target must be below the head block")
+)
+
+// prefixNames are the names of the known key prefixes, which are all a single byte long
+var prefixNames = map[byte]string{
+	DIFFICULTY[0]:       "DIFFICULTY",
+	HEADER[0]:           "HEADER",
+	HEAD[0]:             "HEAD",
+	FORK[0]:             "FORK",
+	CANONICAL[0]:        "CANONICAL",
+	BODY[0]:             "BODY",
+	RECEIPTS[0]:         "RECEIPTS",
+	SNAPSHOTS[0]:        "SNAPSHOTS",
+	TX_LOOKUP_PREFIX[0]: "TX_LOOKUP_PREFIX",
+}
+
+// otherPrefixName is the name under which the entries with an unknown prefix are counted
+const otherPrefixName = "OTHER"
+
+// PrefixStats is the number and the total size of the entries under a key prefix
+type PrefixStats struct {
+	Name  string
+	Count uint64
+	Size  uint64
+}
+
+// Inspect returns the stats of the entries under each key prefix of the storage in the key order.
+// The entries with an unknown prefix are counted together
+func Inspect(s IterableStorage) ([]*PrefixStats, error) {
+	stats := make(map[string]*PrefixStats)
+	order := []string{}
+
+	err := s.Iterate(nil, func(k, v []byte) bool {
+		name := otherPrefixName
+		if len(k) > 0 {
+			if n, ok := prefixNames[k[0]]; ok {
+				name = n
+			}
+		}
+
+		st, ok := stats[name]
+		if !ok {
+			st = &PrefixStats{Name: name}
+			stats[name] = st
+			order = append(order, name)
+		}
+
+		st.Count++
+		st.Size += uint64(len(k) + len(v))
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*PrefixStats, 0, len(order))
+	for _, name := range order {
+		result = append(result, stats[name])
+	}
+
+	return result, nil
+}
+
+// VerifyIssue is an inconsistency found in a block of the canonical chain
+type VerifyIssue struct {
+	Number uint64
+	Reason string
+}
+
+// VerifyResult is the outcome of the canonical chain verification
+type VerifyResult struct {
+	Head         uint64
+	Transactions uint64
+	Issues       []VerifyIssue
+}
+
+// Verify checks that every block of the canonical chain up to the head has its header linked
+// to the parent, its total difficulty, its body and the receipts of all its transactions,
+// and that the transaction lookups point to it. The receipts are not checked for the blocks
+// written by the state sync without their receipts. It stops after the given number of issues
+func Verify(s Storage, maxIssues int) (*VerifyResult, error) {
+	head, ok := s.ReadHeadNumber()
+	if !ok {
+		return nil, ErrHeadNotFound
+	}
+
+	// the first receipts number is not stored when all the blocks have been executed
+	firstReceipts, _ := s.ReadFirstReceiptsNumber()
+
+	result := &VerifyResult{Head: head}
+
+	report := func(number uint64, format string, args ...interface{}) {
+		result.Issues = append(result.Issues, VerifyIssue{Number: number, Reason: fmt.Sprintf(format, args...)})
+	}
+
+	if headHash, ok := s.ReadHeadHash(); !ok {
+		report(head, "head hash not found")
+	} else if canonical, ok := s.ReadCanonicalHash(head); ok && canonical != headHash {
+		report(head, "head hash %s does not match the canonical hash %s", headHash, canonical)
+	}
+
+	var parentHash types.Hash
+
+	for n := uint64(0); n <= head && len(result.Issues) < maxIssues; n++ {
+		hash, ok := s.ReadCanonicalHash(n)
+		if !ok {
+			report(n, "canonical hash not found")
+
+			parentHash = types.ZeroHash
+
+			continue
+		}
+
+		header, err := s.ReadHeader(hash)
+		if err != nil {
+			report(n, "failed to read header %s: %v", hash, err)
+
+			parentHash = hash
+
+			continue
+		}
+
+		if header.ComputeHash(); header.Hash != hash {
+			report(n, "header hash %s does not match the canonical hash %s", header.Hash, hash)
+		}
+
+		if header.Number != n {
+			report(n, "header has number %d", header.Number)
+		}
+
+		if n > 0 && parentHash != types.ZeroHash && header.ParentHash != parentHash {
+			report(n, "parent hash %s does not match the canonical hash %s", header.ParentHash, parentHash)
+		}
+
+		parentHash = hash
+
+		if _, ok := s.ReadTotalDifficulty(hash); !ok {
+			report(n, "total difficulty not found")
+		}
+
+		// the genesis block has no body
+		if n == 0 {
+			continue
+		}
+
+		body, err := s.ReadBody(hash)
+		if err != nil {
+			report(n, "failed to read body: %v", err)
+
+			continue
+		}
+
+		if n >= firstReceipts {
+			receipts, err := s.ReadReceipts(hash)
+			if err != nil {
+				report(n, "failed to read receipts: %v", err)
+			} else if len(receipts) != len(body.Transactions) {
+				report(n, "%d receipts found for %d transactions", len(receipts), len(body.Transactions))
+			}
+		}
+
+		for _, tx := range body.Transactions {
+			if lookup, ok := s.ReadTxLookup(tx.Hash()); !ok {
+				report(n, "lookup of transaction %s not found", tx.Hash())
+			} else if lookup != hash {
+				report(n, "lookup of transaction %s points to block %s", tx.Hash(), lookup)
+			}
+		}
+
+		result.Transactions += uint64(len(body.Transactions))
+	}
+
+	return result, nil
+}
+
+// Rewind sets the head to the given canonical block and deletes the canonical blocks above it,
+// along with their transaction lookups. The head is moved first, so an interrupted rewind
+// leaves only unreachable entries behind
+func Rewind(s Storage, to uint64) error {
+	head, ok := s.ReadHeadNumber()
+	if !ok {
+		return ErrHeadNotFound
+	}
+
+	if to >= head {
+		return fmt.Errorf("%w: target %d, head %d", ErrInvalidRewindTarget, to, head)
+	}
+
+	hash, ok := s.ReadCanonicalHash(to)
+	if !ok {
+		return fmt.Errorf("canonical hash of block %d not found", to)
+	}
+
+	if _, err := s.ReadHeader(hash); err != nil {
+		return fmt.Errorf("failed to read header of block %d: %w", to, err)
+	}
+
+	forks, err := s.ReadForks()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+
+	// keep only the forks which are not above the new head
+	retainedForks := make([]types.Hash, 0, len(forks))
+
+	for _, fork := range forks {
+		if header, err := s.ReadHeader(fork); err == nil && header.Number <= to {
+			retainedForks = append(retainedForks, fork)
+		}
+	}
+
+	batch := NewBatchWriter(s)
+	batch.PutHeadHash(hash)
+	batch.PutHeadNumber(to)
+	batch.PutForks(retainedForks)
+
+	if err := batch.WriteBatch(); err != nil {
+		return err
+	}
+
+	batch = NewBatchWriter(s)
+
+	for n := head; n > to; n-- {
+		if err := deleteCanonicalBlock(s, batch, n); err != nil {
+			return err
+		}
+
+		if (head-n+1)%maintenanceBatchBlocks == 0 {
+			if err := batch.WriteBatch(); err != nil {
+				return err
+			}
+
+			batch = NewBatchWriter(s)
+		}
+	}
+
+	return batch.WriteBatch()
+}
+
+// deleteCanonicalBlock adds the deletion of the canonical block with the given number to the batch
+func deleteCanonicalBlock(s Storage, batch *BatchWriter, n uint64) error {
+	hash, ok := s.ReadCanonicalHash(n)
+	if !ok {
+		return nil
+	}
+
+	batch.DeleteCanonicalHash(n)
+
+	if body, err := s.ReadBody(hash); err == nil {
+		for _, tx := range body.Transactions {
+			// the transaction may be included by a block which is kept
+			if lookup, ok := s.ReadTxLookup(tx.Hash()); ok && lookup == hash {
+				batch.DeleteTxLookup(tx.Hash())
+			}
+		}
+	} else if !errors.Is(err, ErrNotFound) {
+		return fmt.Errorf("failed to read body of block %d: %w", n, err)
+	}
+
+	batch.DeleteBlock(hash)
+
+	return nil
+}
+
+// ReindexTxLookup deletes all the transaction lookups and rebuilds them
+// from the bodies of the canonical chain. It returns the number of the indexed transactions
+func ReindexTxLookup(s IterableStorage) (uint64, error) {
+	head, ok := s.ReadHeadNumber()
+	if !ok {
+		return 0, ErrHeadNotFound
+	}
+
+	var (
+		batch   = NewBatchWriter(s)
+		deleted = 0
+		err     error
+	)
+
+	iterErr := s.Iterate(TX_LOOKUP_PREFIX, func(k, _ []byte) bool {
+		batch.DeleteTxLookup(types.BytesToHash(k[len(TX_LOOKUP_PREFIX):]))
+
+		if deleted++; deleted%(maintenanceBatchBlocks*100) == 0 {
+			if err = batch.WriteBatch(); err != nil {
+				return false
+			}
+
+			batch = NewBatchWriter(s)
+		}
+
+		return true
+	})
+	if iterErr != nil {
+		return 0, iterErr
+	}
+
+	if err != nil {
+		return 0, err
+	}
+
+	if err := batch.WriteBatch(); err != nil {
+		return 0, err
+	}
+
+	batch = NewBatchWriter(s)
+	indexed := uint64(0)
+
+	for n := uint64(1); n <= head; n++ {
+		hash, ok := s.ReadCanonicalHash(n)
+		if !ok {
+			return 0, fmt.Errorf("canonical hash of block %d not found", n)
+		}
+
+		body, err := s.ReadBody(hash)
+		if err != nil {
+			return 0, fmt.Errorf("failed to read body of block %d: %w", n, err)
+		}
+
+		for _, tx := range body.Transactions {
+			batch.PutTxLookup(tx.Hash(), hash)
+		}
+
+		indexed += uint64(len(body.Transactions))
+
+		if n%maintenanceBatchBlocks == 0 {
+			if err := batch.WriteBatch(); err != nil {
+				return 0, err
+			}
+
+			batch = NewBatchWriter(s)
+		}
+	}
+
+	if err := batch.WriteBatch(); err != nil {
+		return 0, err
+	}
+
+	return indexed, nil
+}
@@ -0,0 +1,249 @@
+package storage
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// mapKV is a minimal iterable kv database for the maintenance tests
+type mapKV map[string][]byte
+
+func (m mapKV) Close() error { return nil }
+
+func (m mapKV) Get(k []byte) ([]byte, bool, error) {
+	v, ok := m[string(k)]
+
+	return v, ok, nil
+}
+
+func (m mapKV) NewBatch() Batch { return &mapBatch{db: m} }
+
+func (m mapKV) Iterate(prefix []byte, handler func(k, v []byte) bool) error {
+	keys := []string{}
+
+	for k := range m {
+		if bytes.HasPrefix([]byte(k), prefix) {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		if !handler([]byte(k), m[k]) {
+			break
+		}
+	}
+
+	return nil
+}
+
+type mapBatch struct {
+	db  mapKV
+	ops []func()
+}
+
+func (b *mapBatch) Put(k, v []byte) { b.ops = append(b.ops, func() { b.db[string(k)] = v }) }
+
+func (b *mapBatch) Delete(k []byte) { b.ops = append(b.ops, func() { delete(b.db, string(k)) }) }
+
+func (b *mapBatch) Write() error {
+	for _, op := range b.ops {
+		op()
+	}
+
+	return nil
+}
+
+// newTestChain writes a canonical chain of the given length, with a transaction in each block
+func newTestChain(t *testing.T, head uint64) (IterableStorage, []*types.Block) {
+	t.Helper()
+
+	s, ok := NewKeyValueStorage(hclog.NewNullLogger(), mapKV{}).(IterableStorage)
+	require.True(t, ok)
+
+	genesis := &types.Header{ExtraData: []byte{}}
+	genesis.ComputeHash()
+
+	batch := NewBatchWriter(s)
+	batch.PutCanonicalHeader(genesis, big.NewInt(1))
+
+	blocks := []*types.Block{{Header: genesis}}
+
+	for n := uint64(1); n <= head; n++ {
+		header := &types.Header{
+			Number:     n,
+			ParentHash: blocks[n-1].Hash(),
+			ExtraData:  []byte{},
+		}
+		header.ComputeHash()
+
+		tx := types.NewTx(types.NewLegacyTx(
+			types.WithNonce(n),
+			types.WithGasPrice(big.NewInt(1)),
+			types.WithSignatureValues(big.NewInt(1), nil, nil),
+		))
+		tx.ComputeHash()
+
+		block := &types.Block{Header: header, Transactions: []*types.Transaction{tx}}
+
+		batch.PutCanonicalHeader(header, big.NewInt(int64(n+1)))
+		batch.PutBody(header.Hash, block.Body())
+		batch.PutReceipts(header.Hash, []*types.Receipt{{TxHash: tx.Hash()}})
+		batch.PutTxLookup(tx.Hash(), header.Hash)
+
+		blocks = append(blocks, block)
+	}
+
+	require.NoError(t, batch.WriteBatch())
+
+	return s, blocks
+}
+
+func TestInspect(t *testing.T) {
+	t.Parallel()
+
+	s, _ := newTestChain(t, 10)
+
+	stats, err := Inspect(s)
+	require.NoError(t, err)
+
+	counts := map[string]uint64{}
+	for _, st := range stats {
+		require.NotZero(t, st.Size)
+
+		counts[st.Name] = st.Count
+	}
+
+	require.Equal(t, map[string]uint64{
+		"BODY":             10,
+		"CANONICAL":        11,
+		"DIFFICULTY":       11,
+		"HEADER":           11,
+		"HEAD":             2,
+		"RECEIPTS":         10,
+		"TX_LOOKUP_PREFIX": 10,
+	}, counts)
+}
+
+func TestVerify(t *testing.T) {
+	t.Parallel()
+
+	s, blocks := newTestChain(t, 10)
+
+	result, err := Verify(s, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), result.Head)
+	require.Equal(t, uint64(10), result.Transactions)
+	require.Empty(t, result.Issues)
+
+	// drop the receipts and a tx lookup as if the node crashed mid-write
+	batch := NewBatchWriter(s)
+	batch.deleteWithPrefix(RECEIPTS, blocks[4].Hash().Bytes())
+	batch.DeleteTxLookup(blocks[7].Transactions[0].Hash())
+	require.NoError(t, batch.WriteBatch())
+
+	result, err = Verify(s, 100)
+	require.NoError(t, err)
+	require.Len(t, result.Issues, 2)
+	require.Equal(t, uint64(4), result.Issues[0].Number)
+	require.Equal(t, uint64(7), result.Issues[1].Number)
+
+	result, err = Verify(s, 1)
+	require.NoError(t, err)
+	require.Len(t, result.Issues, 1)
+}
+
+func TestVerify_BlocksWithoutReceipts(t *testing.T) {
+	t.Parallel()
+
+	s, blocks := newTestChain(t, 10)
+
+	// drop the receipts of the blocks preceding the first receipts number, as the state sync writes them
+	batch := NewBatchWriter(s)
+	for n := 1; n < 6; n++ {
+		batch.deleteWithPrefix(RECEIPTS, blocks[n].Hash().Bytes())
+	}
+
+	batch.PutFirstReceiptsNumber(6)
+	require.NoError(t, batch.WriteBatch())
+
+	result, err := Verify(s, 100)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), result.Transactions)
+	require.Empty(t, result.Issues)
+
+	// the receipts of the blocks from the first receipts number on are still checked
+	batch = NewBatchWriter(s)
+	batch.deleteWithPrefix(RECEIPTS, blocks[6].Hash().Bytes())
+	require.NoError(t, batch.WriteBatch())
+
+	result, err = Verify(s, 100)
+	require.NoError(t, err)
+	require.Len(t, result.Issues, 1)
+	require.Equal(t, uint64(6), result.Issues[0].Number)
+}
+
+func TestRewind(t *testing.T) {
+	t.Parallel()
+
+	s, blocks := newTestChain(t, 10)
+
+	require.ErrorIs(t, Rewind(s, 10), ErrInvalidRewindTarget)
+	require.NoError(t, Rewind(s, 6))
+
+	head, ok := s.ReadHeadNumber()
+	require.True(t, ok)
+	require.Equal(t, uint64(6), head)
+
+	headHash, ok := s.ReadHeadHash()
+	require.True(t, ok)
+	require.Equal(t, blocks[6].Hash(), headHash)
+
+	_, ok = s.ReadCanonicalHash(7)
+	require.False(t, ok)
+
+	_, err := s.ReadHeader(blocks[7].Hash())
+	require.ErrorIs(t, err, ErrNotFound)
+
+	_, ok = s.ReadTxLookup(blocks[7].Transactions[0].Hash())
+	require.False(t, ok)
+
+	_, ok = s.ReadTxLookup(blocks[6].Transactions[0].Hash())
+	require.True(t, ok)
+
+	result, err := Verify(s, 100)
+	require.NoError(t, err)
+	require.Empty(t, result.Issues)
+}
+
+func TestReindexTxLookup(t *testing.T) {
+	t.Parallel()
+
+	s, blocks := newTestChain(t, 10)
+
+	stale := types.StringToHash("1")
+
+	batch := NewBatchWriter(s)
+	batch.DeleteTxLookup(blocks[3].Transactions[0].Hash())
+	batch.PutTxLookup(stale, blocks[2].Hash())
+	require.NoError(t, batch.WriteBatch())
+
+	indexed, err := ReindexTxLookup(s)
+	require.NoError(t, err)
+	require.Equal(t, uint64(10), indexed)
+
+	_, ok := s.ReadTxLookup(stale)
+	require.False(t, ok)
+
+	result, err := Verify(s, 100)
+	require.NoError(t, err)
+	require.Empty(t, result.Issues)
+}
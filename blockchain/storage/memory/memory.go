@@ -1,6 +1,9 @@
 package memory
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/hashicorp/go-hclog"
@@ -33,6 +36,33 @@ func (m *memoryKV) Get(p []byte) ([]byte, bool, error) {
 	return v, true, nil
 }
 
+func (m *memoryKV) Iterate(prefix []byte, handler func(k, v []byte) bool) error {
+	keys := make([][]byte, 0, len(m.db))
+
+	for hexKey := range m.db {
+		k, err := hex.DecodeHex(hexKey)
+		if err != nil {
+			return err
+		}
+
+		if bytes.HasPrefix(k, prefix) {
+			keys = append(keys, k)
+		}
+	}
+
+	sort.Slice(keys, func(i, j int) bool {
+		return bytes.Compare(keys[i], keys[j]) < 0
+	})
+
+	for _, k := range keys {
+		if !handler(k, m.db[hex.EncodeToHex(k)]) {
+			break
+		}
+	}
+
+	return nil
+}
+
 func (m *memoryKV) Close() error {
 	return nil
 }
@@ -0,0 +1,32 @@
+package pebble
+
+import (
+	"github.com/cockroachdb/pebble"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+)
+
+var _ storage.Batch = (*batchPebble)(nil)
+
+type batchPebble struct {
+	b *pebble.Batch
+}
+
+func NewBatchPebble(db *pebble.DB) *batchPebble {
+	return &batchPebble{
+		b: db.NewBatch(),
+	}
+}
+
+func (b *batchPebble) Delete(key []byte) {
+	// the batch errors only when it's committed or closed
+	_ = b.b.Delete(key, nil)
+}
+
+func (b *batchPebble) Put(k []byte, v []byte) {
+	_ = b.b.Set(k, v, nil)
+}
+
+func (b *batchPebble) Write() error {
+	return b.b.Commit(pebble.NoSync)
+}
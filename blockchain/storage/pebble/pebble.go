@@ -0,0 +1,171 @@
+package pebble
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+)
+
+const (
+	DefaultCache   = int64(256)
+	DefaultHandles = int(256)
+)
+
+// Factory creates a pebble storage
+func Factory(config map[string]interface{}, logger hclog.Logger) (storage.Storage, error) {
+	path, ok := config["path"]
+	if !ok {
+		return nil, fmt.Errorf("path not found")
+	}
+
+	pathStr, ok := path.(string)
+	if !ok {
+		return nil, fmt.Errorf("path is not a string")
+	}
+
+	return NewPebbleStorage(pathStr, logger)
+}
+
+// NewPebbleStorage creates the new storage reference with pebble default options
+func NewPebbleStorage(path string, logger hclog.Logger) (storage.Storage, error) {
+	logger = logger.Named("pebble")
+
+	db, err := OpenDB(path, logger)
+	if err != nil {
+		return nil, err
+	}
+
+	kv := &pebbleKV{db}
+
+	return storage.NewKeyValueStorage(logger, kv), nil
+}
+
+// OpenDB opens the pebble database in the given directory with the default options
+func OpenDB(path string, logger hclog.Logger) (*pebble.DB, error) {
+	cache := pebble.NewCache(DefaultCache * 1024 * 1024)
+	defer cache.Unref()
+
+	return pebble.Open(path, &pebble.Options{
+		Cache:        cache,
+		Logger:       &pebbleLogger{logger},
+		MaxOpenFiles: DefaultHandles,
+		MemTableSize: uint64(DefaultCache / 4 * 1024 * 1024),
+		// compact the level 0 early and never stop the writes because of its size,
+		// so that the block writes are not stalled by the compactions
+		L0CompactionThreshold:       2,
+		L0StopWritesThreshold:       1000,
+		MemTableStopWritesThreshold: 4,
+	})
+}
+
+// pebbleLogger writes the logs of the pebble database to the node logger
+type pebbleLogger struct {
+	logger hclog.Logger
+}
+
+// Infof logs the routine database events (e.g. the WAL replay) at the debug level
+func (l *pebbleLogger) Infof(format string, args ...interface{}) {
+	l.logger.Debug(fmt.Sprintf(format, args...))
+}
+
+// Fatalf logs the unrecoverable database error and exits, as the default pebble logger does
+func (l *pebbleLogger) Fatalf(format string, args ...interface{}) {
+	l.logger.Error(fmt.Sprintf(format, args...))
+	os.Exit(1)
+}
+
+// Get retrieves the value of the key from the pebble database
+func Get(db *pebble.DB, k []byte) ([]byte, bool, error) {
+	data, closer, err := db.Get(k)
+	if err != nil {
+		if errors.Is(err, pebble.ErrNotFound) {
+			return nil, false, nil
+		}
+
+		return nil, false, err
+	}
+	defer closer.Close()
+
+	// the returned slice is valid only until the closer is called
+	return append([]byte{}, data...), true, nil
+}
+
+// pebbleKV is the pebble implementation of the kv storage
+type pebbleKV struct {
+	db *pebble.DB
+}
+
+// Set sets the key-value pair in pebble storage
+func (p *pebbleKV) Set(k []byte, v []byte) error {
+	return p.db.Set(k, v, pebble.NoSync)
+}
+
+// Get retrieves the key-value pair in pebble storage
+func (p *pebbleKV) Get(k []byte) ([]byte, bool, error) {
+	return Get(p.db, k)
+}
+
+// Iterate iterates over the key-value pairs with the given prefix in pebble storage
+func (p *pebbleKV) Iterate(prefix []byte, handler func(k, v []byte) bool) error {
+	iter, err := p.db.NewIter(PrefixIterOptions(prefix))
+	if err != nil {
+		return err
+	}
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if !handler(iter.Key(), iter.Value()) {
+			break
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		_ = iter.Close()
+
+		return err
+	}
+
+	return iter.Close()
+}
+
+// Close closes the pebble storage instance
+func (p *pebbleKV) Close() error {
+	return p.db.Close()
+}
+
+func (p *pebbleKV) NewBatch() storage.Batch {
+	return NewBatchPebble(p.db)
+}
+
+// PrefixIterOptions returns the options of an iterator over the keys with the given prefix
+func PrefixIterOptions(prefix []byte) *pebble.IterOptions {
+	if len(prefix) == 0 {
+		return nil
+	}
+
+	return &pebble.IterOptions{
+		LowerBound: prefix,
+		UpperBound: PrefixUpperBound(prefix),
+	}
+}
+
+// PrefixUpperBound returns the smallest key which is greater than all the keys with the given prefix,
+// or nil if there is no such key
+func PrefixUpperBound(prefix []byte) []byte {
+	end := bytes.Clone(prefix)
+
+	for i := len(end) - 1; i >= 0; i-- {
+		if end[i] < 0xff {
+			end[i]++
+
+			return end[:i+1]
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,64 @@
+package pebble
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func newStorage(t *testing.T) (storage.Storage, func()) {
+	t.Helper()
+
+	s, err := NewPebbleStorage(t.TempDir(), hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	closeFn := func() {
+		require.NoError(t, s.Close())
+	}
+
+	return s, closeFn
+}
+
+func TestStorage(t *testing.T) {
+	storage.TestStorage(t, newStorage)
+}
+
+func TestStorage_Reopen(t *testing.T) {
+	t.Parallel()
+
+	path := t.TempDir()
+	hash := types.StringToHash("0x1")
+
+	s, err := NewPebbleStorage(path, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	batch := storage.NewBatchWriter(s)
+	batch.PutCanonicalHash(1, hash)
+	require.NoError(t, batch.WriteBatch())
+	require.NoError(t, s.Close())
+
+	// the written data is persisted
+	s, err = NewPebbleStorage(path, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	defer s.Close()
+
+	stored, ok := s.ReadCanonicalHash(1)
+	require.True(t, ok)
+	require.Equal(t, hash, stored)
+
+	_, ok = s.ReadCanonicalHash(2)
+	require.False(t, ok)
+}
+
+func TestPrefixUpperBound(t *testing.T) {
+	t.Parallel()
+
+	require.Equal(t, []byte{0x1, 0x3}, PrefixUpperBound([]byte{0x1, 0x2}))
+	require.Equal(t, []byte{0x2}, PrefixUpperBound([]byte{0x1, 0xff}))
+	require.Nil(t, PrefixUpperBound([]byte{0xff, 0xff}))
+}
@@ -14,6 +14,8 @@ type Storage interface {
 	ReadHeadHash() (types.Hash, bool)
 	ReadHeadNumber() (uint64, bool)
 
+	ReadFirstReceiptsNumber() (uint64, bool)
+
 	ReadForks() ([]types.Hash, error)
 
 	ReadTotalDifficulty(hash types.Hash) (*big.Int, bool)
@@ -31,5 +33,14 @@ type Storage interface {
 	Close() error
 }
 
+// IterableStorage is a blockchain storage whose raw entries can be iterated over
+type IterableStorage interface {
+	Storage
+
+	// Iterate calls the handler for the entries whose keys have the given prefix in the key order
+	// until it returns false. The key and the value are valid only until the handler returns
+	Iterate(prefix []byte, handler func(k, v []byte) bool) error
+}
+
 // Factory is a factory method to create a blockchain storage
 type Factory func(config map[string]interface{}, logger hclog.Logger) (Storage, error)
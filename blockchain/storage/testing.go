@@ -5,6 +5,7 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
@@ -49,6 +50,9 @@ func TestStorage(t *testing.T, m PlaceholderStorage) {
 	t.Run("testReceipts", func(t *testing.T) {
 		testReceipts(t, m)
 	})
+	t.Run("testIterate", func(t *testing.T) {
+		testIterate(t, m)
+	})
 }
 
 func testCanonicalChain(t *testing.T, m PlaceholderStorage) {
@@ -453,6 +457,7 @@ func testWriteCanonicalHeader(t *testing.T, m PlaceholderStorage) {
 type readCanonicalHashDelegate func(uint64) (types.Hash, bool)
 type readHeadHashDelegate func() (types.Hash, bool)
 type readHeadNumberDelegate func() (uint64, bool)
+type readFirstReceiptsNumberDelegate func() (uint64, bool)
 type readForksDelegate func() ([]types.Hash, error)
 type readTotalDifficultyDelegate func(types.Hash) (*big.Int, bool)
 type readHeaderDelegate func(types.Hash) (*types.Header, error)
@@ -467,6 +472,7 @@ type MockStorage struct {
 	readCanonicalHashFn   readCanonicalHashDelegate
 	readHeadHashFn        readHeadHashDelegate
 	readHeadNumberFn      readHeadNumberDelegate
+	readFirstReceiptsFn   readFirstReceiptsNumberDelegate
 	readForksFn           readForksDelegate
 	readTotalDifficultyFn readTotalDifficultyDelegate
 	readHeaderFn          readHeaderDelegate
@@ -517,6 +523,18 @@ func (m *MockStorage) HookReadHeadNumber(fn readHeadNumberDelegate) {
 	m.readHeadNumberFn = fn
 }
 
+func (m *MockStorage) ReadFirstReceiptsNumber() (uint64, bool) {
+	if m.readFirstReceiptsFn != nil {
+		return m.readFirstReceiptsFn()
+	}
+
+	return 0, false
+}
+
+func (m *MockStorage) HookReadFirstReceiptsNumber(fn readFirstReceiptsNumberDelegate) {
+	m.readFirstReceiptsFn = fn
+}
+
 func (m *MockStorage) ReadForks() ([]types.Hash, error) {
 	if m.readForksFn != nil {
 		return m.readForksFn()
@@ -612,3 +630,42 @@ func (m *MockStorage) NewBatch() Batch {
 
 	return nil
 }
+
+func testIterate(t *testing.T, m PlaceholderStorage) {
+	t.Helper()
+
+	s, closeFn := m(t)
+	defer closeFn()
+
+	iterable, ok := s.(IterableStorage)
+	require.True(t, ok)
+
+	batch := NewBatchWriter(s)
+
+	for i := uint64(3); i > 0; i-- {
+		batch.PutCanonicalHash(i, types.BytesToHash([]byte{byte(i)}))
+	}
+
+	batch.PutHeadNumber(3)
+	require.NoError(t, batch.WriteBatch())
+
+	numbers := []uint64{}
+
+	require.NoError(t, iterable.Iterate(CANONICAL, func(k, v []byte) bool {
+		numbers = append(numbers, common.EncodeBytesToUint64(k[len(CANONICAL):]))
+		require.Equal(t, types.BytesToHash([]byte{byte(numbers[len(numbers)-1])}).Bytes(), v)
+
+		return true
+	}))
+	require.Equal(t, []uint64{1, 2, 3}, numbers)
+
+	// the iteration stops once the handler returns false
+	count := 0
+
+	require.NoError(t, iterable.Iterate(nil, func(k, v []byte) bool {
+		count++
+
+		return false
+	}))
+	require.Equal(t, 1, count)
+}
@@ -95,6 +95,11 @@ const (
 	EIP3855        = "EIP3855"
 	Berlin         = "Berlin"
 	EIP3607        = "EIP3607"
+	Cancun         = "Cancun"
+	EIP6780        = "EIP6780"
+	Shanghai       = "Shanghai"
+	RIP7212        = "RIP7212"
+	EIP2537        = "EIP2537"
 )
 
 // Forks is map which contains all forks and their starting blocks from genesis
@@ -134,6 +139,11 @@ func (f *Forks) At(block uint64) ForksInTime {
 		EIP3855:        f.IsActive(EIP3855, block),
 		Berlin:         f.IsActive(Berlin, block),
 		EIP3607:        f.IsActive(EIP3607, block),
+		Cancun:         f.IsActive(Cancun, block),
+		EIP6780:        f.IsActive(EIP6780, block),
+		Shanghai:       f.IsActive(Shanghai, block),
+		RIP7212:        f.IsActive(RIP7212, block),
+		EIP2537:        f.IsActive(EIP2537, block),
 	}
 }
 
@@ -187,7 +197,12 @@ type ForksInTime struct {
 	Governance,
 	EIP3855,
 	Berlin,
-	EIP3607 bool
+	EIP3607,
+	Cancun,
+	EIP6780,
+	Shanghai,
+	RIP7212,
+	EIP2537 bool
 }
 
 // AllForksEnabled should contain all supported forks by current edge version
@@ -205,4 +220,9 @@ var AllForksEnabled = &Forks{
 	EIP3855:        NewFork(0),
 	Berlin:         NewFork(0),
 	EIP3607:        NewFork(0),
+	Cancun:         NewFork(0),
+	EIP6780:        NewFork(0),
+	Shanghai:       NewFork(0),
+	RIP7212:        NewFork(0),
+	EIP2537:        NewFork(0),
 }
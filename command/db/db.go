@@ -0,0 +1,37 @@
+package db
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command/db/inspect"
+	"github.com/0xPolygon/polygon-edge/command/db/migrate"
+	"github.com/0xPolygon/polygon-edge/command/db/reindex"
+	"github.com/0xPolygon/polygon-edge/command/db/rewind"
+	"github.com/0xPolygon/polygon-edge/command/db/verify"
+)
+
+func GetCommand() *cobra.Command {
+	dbCmd := &cobra.Command{
+		Use:   "db",
+		Short: "Top level command for inspecting and repairing the databases of a stopped node. Only accepts subcommands.",
+	}
+
+	registerSubcommands(dbCmd)
+
+	return dbCmd
+}
+
+func registerSubcommands(baseCmd *cobra.Command) {
+	baseCmd.AddCommand(
+		// db migrate
+		migrate.GetCommand(),
+		// db inspect
+		inspect.GetCommand(),
+		// db verify
+		verify.GetCommand(),
+		// db rewind
+		rewind.GetCommand(),
+		// db reindex-txlookup
+		reindex.GetCommand(),
+	)
+}
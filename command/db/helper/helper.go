@@ -0,0 +1,94 @@
+package helper
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+)
+
+const (
+	DataDirFlag   = "data-dir"
+	DBBackendFlag = "db-backend"
+)
+
+var (
+	errInvalidDBBackend = fmt.Errorf("database backend must be either %s or %s",
+		server.LevelDBBackend, server.PebbleBackend)
+)
+
+// StorageParams are the parameters of the commands operating on the databases of a stopped node
+type StorageParams struct {
+	DataDir   string
+	DBBackend string
+}
+
+// RegisterFlags registers the data directory and the database backend flags of the command
+func (p *StorageParams) RegisterFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&p.DataDir,
+		DataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().StringVar(
+		&p.DBBackend,
+		DBBackendFlag,
+		string(server.LevelDBBackend),
+		fmt.Sprintf("the database of the node: %s or %s", server.LevelDBBackend, server.PebbleBackend),
+	)
+
+	helper.SetRequiredFlags(cmd, []string{DataDirFlag})
+}
+
+// ValidateFlags validates the database backend
+func (p *StorageParams) ValidateFlags() error {
+	switch server.DBBackend(p.DBBackend) {
+	case server.LevelDBBackend, server.PebbleBackend:
+		return nil
+	default:
+		return errInvalidDBBackend
+	}
+}
+
+// OpenBlockchainStorage opens the blockchain storage of the data directory
+func (p *StorageParams) OpenBlockchainStorage() (storage.IterableStorage, error) {
+	db, err := server.NewBlockchainStorage(
+		server.DBBackend(p.DBBackend), filepath.Join(p.DataDir, "blockchain"), newLogger())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blockchain storage: %w", err)
+	}
+
+	iterable, ok := db.(storage.IterableStorage)
+	if !ok {
+		_ = db.Close()
+
+		return nil, storage.ErrIterationNotSupported
+	}
+
+	return iterable, nil
+}
+
+// OpenStateStorage opens the state trie storage of the data directory
+func (p *StorageParams) OpenStateStorage() (itrie.Storage, error) {
+	db, err := server.NewStateStorage(server.DBBackend(p.DBBackend), filepath.Join(p.DataDir, "trie"), newLogger())
+	if err != nil {
+		return nil, fmt.Errorf("failed to open trie storage: %w", err)
+	}
+
+	return db, nil
+}
+
+func newLogger() hclog.Logger {
+	return hclog.New(&hclog.LoggerOptions{
+		Name:  "db",
+		Level: hclog.LevelFromString("INFO"),
+	})
+}
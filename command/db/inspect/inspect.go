@@ -0,0 +1,37 @@
+package inspect
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+)
+
+func GetCommand() *cobra.Command {
+	inspectCmd := &cobra.Command{
+		Use:     "inspect",
+		Short:   "Shows the number and the size of the blockchain database entries under each key prefix",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	params.RegisterFlags(inspectCmd)
+
+	return inspectCmd
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.ValidateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.inspect(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
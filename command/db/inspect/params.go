@@ -0,0 +1,52 @@
+package inspect
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+)
+
+var (
+	params = &inspectParams{}
+)
+
+type inspectParams struct {
+	dbHelper.StorageParams
+
+	head  uint64
+	stats []*storage.PrefixStats
+}
+
+func (p *inspectParams) inspect() error {
+	db, err := p.OpenBlockchainStorage()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	p.head, _ = db.ReadHeadNumber()
+
+	p.stats, err = storage.Inspect(db)
+
+	return err
+}
+
+func (p *inspectParams) getResult() command.CommandResult {
+	result := &InspectResult{
+		Head:     p.head,
+		Prefixes: make([]PrefixStats, 0, len(p.stats)),
+	}
+
+	for _, st := range p.stats {
+		result.Prefixes = append(result.Prefixes, PrefixStats{
+			Name:  st.Name,
+			Count: st.Count,
+			Size:  st.Size,
+		})
+
+		result.TotalCount += st.Count
+		result.TotalSize += st.Size
+	}
+
+	return result
+}
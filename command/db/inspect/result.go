@@ -0,0 +1,41 @@
+package inspect
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type PrefixStats struct {
+	Name  string `json:"name"`
+	Count uint64 `json:"count"`
+	Size  uint64 `json:"size"`
+}
+
+type InspectResult struct {
+	Head       uint64        `json:"head"`
+	Prefixes   []PrefixStats `json:"prefixes"`
+	TotalCount uint64        `json:"totalCount"`
+	TotalSize  uint64        `json:"totalSize"`
+}
+
+func (r *InspectResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	rows := make([]string, 0, len(r.Prefixes)+2)
+	rows = append(rows, "Prefix|Entries|Size (bytes)")
+
+	for _, p := range r.Prefixes {
+		rows = append(rows, fmt.Sprintf("%s|%d|%d", p.Name, p.Count, p.Size))
+	}
+
+	rows = append(rows, fmt.Sprintf("Total|%d|%d", r.TotalCount, r.TotalSize))
+
+	buffer.WriteString("\n[DB INSPECT]\n")
+	buffer.WriteString(fmt.Sprintf("Head block: %d\n", r.Head))
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
@@ -0,0 +1,51 @@
+package migrate
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+func GetCommand() *cobra.Command {
+	migrateCmd := &cobra.Command{
+		Use: "migrate",
+		Short: "Converts the LevelDB blockchain and state databases in the data directory " +
+			"of a stopped node to Pebble",
+		Run: runCommand,
+	}
+
+	setFlags(migrateCmd)
+	helper.SetRequiredFlags(migrateCmd, params.getRequiredFlags())
+
+	return migrateCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().BoolVar(
+		&params.keepLevelDB,
+		keepLevelDBFlag,
+		true,
+		"keep the LevelDB databases next to the migrated ones (with the .leveldb suffix)",
+	)
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.migrate(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,223 @@
+package migrate
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/opt"
+
+	pebbledb "github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+	"github.com/0xPolygon/polygon-edge/server"
+)
+
+const (
+	dataDirFlag     = dbHelper.DataDirFlag
+	keepLevelDBFlag = "keep-leveldb"
+
+	// batchSize is the size of the written data after which the pebble batch is committed
+	batchSize = 16 * 1024 * 1024
+)
+
+var (
+	params = &migrateParams{}
+)
+
+var (
+	// databases are the directories of the blockchain and state databases in the data directory
+	databases = []string{"blockchain", "trie"}
+
+	errKeyCountMismatch = errors.New("number of the migrated keys does not match")
+)
+
+type migrateParams struct {
+	dataDir     string
+	keepLevelDB bool
+
+	migrated []MigratedDB
+}
+
+func (p *migrateParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+	}
+}
+
+// migrate converts the databases of the data directory one by one. The pebble database
+// is written to a temporary directory and marked as complete once it's verified, then it
+// replaces the original one. The migration can be run again if it's interrupted: the databases
+// already migrated are skipped, and a complete temporary database is not copied again
+func (p *migrateParams) migrate() error {
+	for _, name := range databases {
+		migrated, err := p.migrateDB(name)
+		if err != nil {
+			return fmt.Errorf("failed to migrate %s database: %w", name, err)
+		}
+
+		p.migrated = append(p.migrated, migrated)
+	}
+
+	return nil
+}
+
+func (p *migrateParams) migrateDB(name string) (MigratedDB, error) {
+	path := filepath.Join(p.dataDir, name)
+	tmpPath := path + ".pebble-tmp"
+
+	// the temporary database is complete once its backend is recorded
+	_, err := os.Stat(filepath.Join(tmpPath, server.DBBackendFile))
+	if err != nil && !os.IsNotExist(err) {
+		return MigratedDB{}, err
+	}
+
+	complete := err == nil
+
+	if !complete {
+		backend, err := server.ReadDBBackend(path)
+		if err != nil {
+			return MigratedDB{}, err
+		}
+
+		if backend == server.PebbleBackend {
+			return MigratedDB{Name: name, Skipped: true}, nil
+		}
+
+		if err := os.RemoveAll(tmpPath); err != nil {
+			return MigratedDB{}, err
+		}
+
+		if _, err := copyLevelDBToPebble(path, tmpPath); err != nil {
+			return MigratedDB{}, err
+		}
+
+		if err := server.WriteDBBackend(tmpPath, server.PebbleBackend); err != nil {
+			return MigratedDB{}, err
+		}
+	}
+
+	keys, err := countPebbleKeys(tmpPath)
+	if err != nil {
+		return MigratedDB{}, err
+	}
+
+	// the original database is already gone if the previous run has been interrupted while replacing it
+	if _, err := os.Stat(path); err == nil {
+		if p.keepLevelDB {
+			err = os.Rename(path, path+".leveldb")
+		} else {
+			err = os.RemoveAll(path)
+		}
+
+		if err != nil {
+			return MigratedDB{}, err
+		}
+	} else if !os.IsNotExist(err) {
+		return MigratedDB{}, err
+	}
+
+	if err := os.Rename(tmpPath, path); err != nil {
+		return MigratedDB{}, err
+	}
+
+	return MigratedDB{Name: name, Keys: keys}, nil
+}
+
+// copyLevelDBToPebble copies all the entries of the existing leveldb database
+// into a new pebble database, and returns the number of the copied keys
+func copyLevelDBToPebble(src, dst string) (int, error) {
+	ldb, err := leveldb.OpenFile(src, &opt.Options{ErrorIfMissing: true, ReadOnly: true})
+	if err != nil {
+		return 0, err
+	}
+	defer ldb.Close()
+
+	pdb, err := pebbledb.OpenDB(dst, hclog.NewNullLogger())
+	if err != nil {
+		return 0, err
+	}
+	defer pdb.Close()
+
+	iter := ldb.NewIterator(nil, nil)
+	defer iter.Release()
+
+	batch := pdb.NewBatch()
+	copied := 0
+
+	for iter.Next() {
+		if err := batch.Set(iter.Key(), iter.Value(), nil); err != nil {
+			return 0, err
+		}
+
+		copied++
+
+		if batch.Len() >= batchSize {
+			if err := batch.Commit(pebble.NoSync); err != nil {
+				return 0, err
+			}
+
+			batch = pdb.NewBatch()
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		return 0, err
+	}
+
+	if err := batch.Commit(pebble.Sync); err != nil {
+		return 0, err
+	}
+
+	// verify the migrated database before it replaces the original one
+	count, err := countKeys(pdb)
+	if err != nil {
+		return 0, err
+	}
+
+	if count != copied {
+		return 0, fmt.Errorf("%w: copied %d, found %d", errKeyCountMismatch, copied, count)
+	}
+
+	return copied, nil
+}
+
+// countPebbleKeys returns the number of the keys in the pebble database in the given directory
+func countPebbleKeys(path string) (int, error) {
+	pdb, err := pebbledb.OpenDB(path, hclog.NewNullLogger())
+	if err != nil {
+		return 0, err
+	}
+	defer pdb.Close()
+
+	return countKeys(pdb)
+}
+
+func countKeys(pdb *pebble.DB) (int, error) {
+	iter, err := pdb.NewIter(nil)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	for iter.First(); iter.Valid(); iter.Next() {
+		count++
+	}
+
+	if err := iter.Close(); err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+func (p *migrateParams) getResult() command.CommandResult {
+	return &MigrateResult{
+		Databases:   p.migrated,
+		KeptLevelDB: p.keepLevelDB,
+	}
+}
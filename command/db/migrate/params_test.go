@@ -0,0 +1,111 @@
+package migrate
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+	"github.com/syndtr/goleveldb/leveldb"
+
+	pebbledb "github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+	"github.com/0xPolygon/polygon-edge/server"
+)
+
+func TestMigrate(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+
+	for _, name := range databases {
+		db, err := leveldb.OpenFile(filepath.Join(dataDir, name), nil)
+		require.NoError(t, err)
+
+		for i := 0; i < 1000; i++ {
+			require.NoError(t, db.Put([]byte(fmt.Sprintf("%s-%d", name, i)), []byte{byte(i)}, nil))
+		}
+
+		require.NoError(t, db.Close())
+	}
+
+	p := &migrateParams{dataDir: dataDir, keepLevelDB: true}
+	require.NoError(t, p.migrate())
+	require.Equal(t, []MigratedDB{{Name: "blockchain", Keys: 1000}, {Name: "trie", Keys: 1000}}, p.migrated)
+
+	for _, name := range databases {
+		_, err := os.Stat(filepath.Join(dataDir, name+".leveldb"))
+		require.NoError(t, err)
+
+		_, err = os.Stat(filepath.Join(dataDir, name+".pebble-tmp"))
+		require.True(t, os.IsNotExist(err))
+
+		db, err := pebbledb.OpenDB(filepath.Join(dataDir, name), hclog.NewNullLogger())
+		require.NoError(t, err)
+
+		v, ok, err := pebbledb.Get(db, []byte(name+"-999"))
+		require.NoError(t, err)
+		require.True(t, ok)
+		require.Equal(t, []byte{byte(999 % 256)}, v)
+
+		require.NoError(t, db.Close())
+	}
+}
+
+func TestMigrate_Resume(t *testing.T) {
+	t.Parallel()
+
+	dataDir := t.TempDir()
+
+	for _, name := range databases {
+		db, err := leveldb.OpenFile(filepath.Join(dataDir, name), nil)
+		require.NoError(t, err)
+
+		for i := 0; i < 100; i++ {
+			require.NoError(t, db.Put([]byte(fmt.Sprintf("%s-%d", name, i)), []byte{byte(i)}, nil))
+		}
+
+		require.NoError(t, db.Close())
+	}
+
+	// the first run has migrated the blockchain database, and has been interrupted
+	// after the state database has been copied but before it has replaced the original one
+	p := &migrateParams{dataDir: dataDir}
+
+	_, err := p.migrateDB("blockchain")
+	require.NoError(t, err)
+
+	triePath := filepath.Join(dataDir, "trie")
+
+	_, err = copyLevelDBToPebble(triePath, triePath+".pebble-tmp")
+	require.NoError(t, err)
+	require.NoError(t, server.WriteDBBackend(triePath+".pebble-tmp", server.PebbleBackend))
+	require.NoError(t, os.RemoveAll(triePath))
+
+	p = &migrateParams{dataDir: dataDir}
+	require.NoError(t, p.migrate())
+	require.Equal(t, []MigratedDB{{Name: "blockchain", Skipped: true}, {Name: "trie", Keys: 100}}, p.migrated)
+
+	for _, name := range databases {
+		backend, err := server.ReadDBBackend(filepath.Join(dataDir, name))
+		require.NoError(t, err)
+		require.Equal(t, server.PebbleBackend, backend)
+	}
+
+	// the server refuses to open the migrated databases with the previous backend
+	_, err = server.NewBlockchainStorage(server.LevelDBBackend, filepath.Join(dataDir, "blockchain"), hclog.NewNullLogger())
+	require.ErrorIs(t, err, server.ErrDBBackendMismatch)
+
+	_, err = server.NewStateStorage("", triePath, hclog.NewNullLogger())
+	require.ErrorIs(t, err, server.ErrDBBackendMismatch)
+
+	db, err := server.NewStateStorage(server.PebbleBackend, triePath, hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	v, ok, err := db.Get([]byte("trie-99"))
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []byte{99}, v)
+	require.NoError(t, db.Close())
+}
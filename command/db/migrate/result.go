@@ -0,0 +1,47 @@
+package migrate
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server"
+)
+
+type MigratedDB struct {
+	Name string `json:"name"`
+	Keys int    `json:"keys"`
+	// Skipped is true if the database has already been migrated by a previous run
+	Skipped bool `json:"skipped"`
+}
+
+type MigrateResult struct {
+	Databases   []MigratedDB `json:"databases"`
+	KeptLevelDB bool         `json:"keptLevelDB"`
+}
+
+func (r *MigrateResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	rows := make([]string, 0, len(r.Databases))
+	for _, db := range r.Databases {
+		if db.Skipped {
+			rows = append(rows, fmt.Sprintf("%s|already migrated", db.Name))
+		} else {
+			rows = append(rows, fmt.Sprintf("%s|%d keys", db.Name, db.Keys))
+		}
+	}
+
+	buffer.WriteString("\n[DB MIGRATE]\n")
+	buffer.WriteString("Migrated the databases to Pebble successfully:\n")
+	buffer.WriteString(helper.FormatKV(rows))
+	buffer.WriteString("\n")
+
+	if r.KeptLevelDB {
+		buffer.WriteString("The LevelDB databases are kept with the .leveldb suffix.\n")
+	}
+
+	buffer.WriteString(fmt.Sprintf("Start the server with --db-backend %s\n", server.PebbleBackend))
+
+	return buffer.String()
+}
@@ -0,0 +1,39 @@
+package reindex
+
+import (
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+)
+
+var (
+	params = &reindexParams{}
+)
+
+type reindexParams struct {
+	dbHelper.StorageParams
+
+	head    uint64
+	indexed uint64
+}
+
+func (p *reindexParams) reindex() error {
+	db, err := p.OpenBlockchainStorage()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	p.head, _ = db.ReadHeadNumber()
+
+	p.indexed, err = storage.ReindexTxLookup(db)
+
+	return err
+}
+
+func (p *reindexParams) getResult() command.CommandResult {
+	return &ReindexResult{
+		Head:         p.head,
+		Transactions: p.indexed,
+	}
+}
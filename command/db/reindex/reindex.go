@@ -0,0 +1,37 @@
+package reindex
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+)
+
+func GetCommand() *cobra.Command {
+	reindexCmd := &cobra.Command{
+		Use:     "reindex-txlookup",
+		Short:   "Rebuilds the transaction lookups from the bodies of the canonical chain",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	params.RegisterFlags(reindexCmd)
+
+	return reindexCmd
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.ValidateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.reindex(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,27 @@
+package reindex
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type ReindexResult struct {
+	Head         uint64 `json:"head"`
+	Transactions uint64 `json:"transactions"`
+}
+
+func (r *ReindexResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB REINDEX TX LOOKUP]\n")
+	buffer.WriteString("Rebuilt the transaction lookups successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Head block|%d", r.Head),
+		fmt.Sprintf("Indexed transactions|%d", r.Transactions),
+	}))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
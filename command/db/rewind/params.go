@@ -0,0 +1,71 @@
+package rewind
+
+import (
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+)
+
+const (
+	toFlag = "to"
+)
+
+var (
+	params = &rewindParams{}
+)
+
+type rewindParams struct {
+	dbHelper.StorageParams
+
+	to uint64
+
+	previousHead   uint64
+	stateAvailable bool
+}
+
+func (p *rewindParams) rewind() error {
+	db, err := p.OpenBlockchainStorage()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	head, ok := db.ReadHeadNumber()
+	if !ok {
+		return storage.ErrHeadNotFound
+	}
+
+	if err := storage.Rewind(db, p.to); err != nil {
+		return err
+	}
+
+	p.previousHead = head
+
+	// the node can resume from the new head only if its state has not been pruned
+	hash, _ := db.ReadCanonicalHash(p.to)
+
+	header, err := db.ReadHeader(hash)
+	if err != nil {
+		return fmt.Errorf("failed to read header of block %d: %w", p.to, err)
+	}
+
+	stateStorage, err := p.OpenStateStorage()
+	if err != nil {
+		return err
+	}
+	defer stateStorage.Close()
+
+	_, p.stateAvailable, err = stateStorage.Get(header.StateRoot.Bytes())
+
+	return err
+}
+
+func (p *rewindParams) getResult() command.CommandResult {
+	return &RewindResult{
+		PreviousHead:   p.previousHead,
+		Head:           p.to,
+		StateAvailable: p.stateAvailable,
+	}
+}
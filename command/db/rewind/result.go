@@ -0,0 +1,34 @@
+package rewind
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type RewindResult struct {
+	PreviousHead   uint64 `json:"previousHead"`
+	Head           uint64 `json:"head"`
+	StateAvailable bool   `json:"stateAvailable"`
+}
+
+func (r *RewindResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB REWIND]\n")
+	buffer.WriteString("Rewound the blockchain successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Previous head|%d", r.PreviousHead),
+		fmt.Sprintf("Head|%d", r.Head),
+		fmt.Sprintf("State available|%t", r.StateAvailable),
+	}))
+	buffer.WriteString("\n")
+
+	if !r.StateAvailable {
+		buffer.WriteString("WARNING: the state of the new head is missing (pruned), " +
+			"the node is not able to resume from it\n")
+	}
+
+	return buffer.String()
+}
@@ -0,0 +1,51 @@
+package rewind
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+func GetCommand() *cobra.Command {
+	rewindCmd := &cobra.Command{
+		Use: "rewind",
+		Short: "Resets the head of the blockchain database to the given block and deletes " +
+			"the canonical blocks above it",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(rewindCmd)
+	helper.SetRequiredFlags(rewindCmd, []string{toFlag})
+
+	return rewindCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	params.RegisterFlags(cmd)
+
+	cmd.Flags().Uint64Var(
+		&params.to,
+		toFlag,
+		0,
+		"the number of the new head block",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.ValidateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.rewind(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,65 @@
+package verify
+
+import (
+	"errors"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/command"
+	dbHelper "github.com/0xPolygon/polygon-edge/command/db/helper"
+)
+
+const (
+	maxIssuesFlag = "max-issues"
+
+	defaultMaxIssues = 100
+)
+
+var (
+	params = &verifyParams{}
+)
+
+var (
+	errInvalidMaxIssues = errors.New("max issues must be greater than 0")
+)
+
+type verifyParams struct {
+	dbHelper.StorageParams
+
+	maxIssues int
+
+	result *storage.VerifyResult
+}
+
+func (p *verifyParams) validateFlags() error {
+	if p.maxIssues <= 0 {
+		return errInvalidMaxIssues
+	}
+
+	return p.ValidateFlags()
+}
+
+func (p *verifyParams) verify() error {
+	db, err := p.OpenBlockchainStorage()
+	if err != nil {
+		return err
+	}
+	defer db.Close()
+
+	p.result, err = storage.Verify(db, p.maxIssues)
+
+	return err
+}
+
+func (p *verifyParams) getResult() command.CommandResult {
+	result := &VerifyResult{
+		Head:         p.result.Head,
+		Transactions: p.result.Transactions,
+		Issues:       make([]Issue, 0, len(p.result.Issues)),
+	}
+
+	for _, issue := range p.result.Issues {
+		result.Issues = append(result.Issues, Issue{Block: issue.Number, Reason: issue.Reason})
+	}
+
+	return result
+}
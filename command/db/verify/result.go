@@ -0,0 +1,50 @@
+package verify
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type Issue struct {
+	Block  uint64 `json:"block"`
+	Reason string `json:"reason"`
+}
+
+type VerifyResult struct {
+	Head         uint64  `json:"head"`
+	Transactions uint64  `json:"transactions"`
+	Issues       []Issue `json:"issues"`
+}
+
+func (r *VerifyResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[DB VERIFY]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Head block|%d", r.Head),
+		fmt.Sprintf("Transactions|%d", r.Transactions),
+		fmt.Sprintf("Issues|%d", len(r.Issues)),
+	}))
+	buffer.WriteString("\n")
+
+	if len(r.Issues) == 0 {
+		buffer.WriteString("The canonical chain is consistent\n")
+
+		return buffer.String()
+	}
+
+	rows := make([]string, 0, len(r.Issues)+1)
+	rows = append(rows, "Block|Issue")
+
+	for _, issue := range r.Issues {
+		rows = append(rows, fmt.Sprintf("%d|%s", issue.Block, issue.Reason))
+	}
+
+	buffer.WriteString("\n")
+	buffer.WriteString(helper.FormatList(rows))
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
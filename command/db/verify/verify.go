@@ -0,0 +1,49 @@
+package verify
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+)
+
+func GetCommand() *cobra.Command {
+	verifyCmd := &cobra.Command{
+		Use: "verify",
+		Short: "Checks that the canonical chain in the blockchain database is linked and has " +
+			"the bodies, the receipts and the transaction lookups of all its blocks",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(verifyCmd)
+
+	return verifyCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	params.RegisterFlags(cmd)
+
+	cmd.Flags().IntVar(
+		&params.maxIssues,
+		maxIssuesFlag,
+		defaultMaxIssues,
+		"the number of the found issues after which the verification stops",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.verify(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/genesis/predeploy"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/txpool"
 )
 
 func GetCommand() *cobra.Command {
@@ -223,6 +224,21 @@ func setFlags(cmd *cobra.Command) {
 			"configuration for block time drift value (in seconds)",
 		)
 
+		cmd.Flags().StringVar(
+			&params.txOrdering,
+			txOrderingFlag,
+			txpool.FeeOrdering,
+			fmt.Sprintf("the order in which the block proposer includes the pool transactions (%s or %s)",
+				txpool.FeeOrdering, txpool.FIFOOrdering),
+		)
+
+		cmd.Flags().Uint64Var(
+			&params.txOrderingMaxSenderTxs,
+			txOrderingMaxSenderTxsFlag,
+			0,
+			"the maximum number of transactions of a single sender in a block, value of 0 disables it",
+		)
+
 		cmd.Flags().DurationVar(
 			&params.blockTrackerPollInterval,
 			blockTrackerPollIntervalFlag,
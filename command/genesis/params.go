@@ -12,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -93,6 +94,9 @@ type genesisParams struct {
 	epochReward    uint64
 	blockTimeDrift uint64
 
+	txOrdering             string
+	txOrderingMaxSenderTxs uint64
+
 	initialStateRoot string
 
 	// access lists
@@ -190,6 +194,10 @@ func (p *genesisParams) validateFlags() error {
 			return err
 		}
 
+		if _, err := txpool.NewOrderingPolicy(p.txOrdering, p.txOrderingMaxSenderTxs); err != nil {
+			return err
+		}
+
 		var err error
 
 		p.stakeTokenAddr, err = types.IsValidAddress(params.stakeToken, false)
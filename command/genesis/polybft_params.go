@@ -30,6 +30,9 @@ const (
 
 	blockTimeDriftFlag = "block-time-drift"
 
+	txOrderingFlag             = "tx-ordering"
+	txOrderingMaxSenderTxsFlag = "tx-ordering-max-sender-txs"
+
 	defaultSprintSize               = uint64(5) // in blocks
 	defaultEpochReward              = 1         // in blocks
 	defaultBlockTime                = 2 * time.Second
@@ -198,6 +201,10 @@ func (p *genesisParams) generateChainConfig(o command.OutputFormatter) error {
 			ForkParamsAddr:    contracts.ForkParamsContract,
 		},
 		StakeTokenAddr: p.stakeTokenAddr,
+		TxOrdering: &polybft.TxOrderingConfig{
+			Policy:       p.txOrdering,
+			MaxSenderTxs: p.txOrderingMaxSenderTxs,
+		},
 	}
 
 	// Disable london hardfork if burn contract address is not provided
@@ -0,0 +1,162 @@
+package prune
+
+import (
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/server"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	dataDirFlag   = "data-dir"
+	retentionFlag = "retention"
+	keepRootFlag  = "keep-root"
+	dbBackendFlag = "db-backend"
+)
+
+var (
+	params = &pruneParams{}
+)
+
+var (
+	errRetentionTooLow  = fmt.Errorf("retention must be at least %d", server.MinStateRetention)
+	errHeadNotFound     = errors.New("head block not found")
+	errInvalidDBBackend = fmt.Errorf("database backend must be either %s or %s",
+		server.LevelDBBackend, server.PebbleBackend)
+)
+
+type pruneParams struct {
+	dataDir   string
+	retention uint64
+	keepRoots []string
+	dbBackend string
+
+	head   uint64
+	result *itrie.PruneResult
+}
+
+// compactableStorage is a prunable storage whose disk space can be reclaimed after pruning
+type compactableStorage interface {
+	itrie.PrunableStorage
+	Compact() error
+}
+
+func (p *pruneParams) getRequiredFlags() []string {
+	return []string{
+		dataDirFlag,
+	}
+}
+
+func (p *pruneParams) validateFlags() error {
+	if p.retention < server.MinStateRetention {
+		return errRetentionTooLow
+	}
+
+	switch server.DBBackend(p.dbBackend) {
+	case server.LevelDBBackend, server.PebbleBackend:
+	default:
+		return errInvalidDBBackend
+	}
+
+	for _, root := range p.keepRoots {
+		if b, err := hex.DecodeHex(root); err != nil || len(b) != types.HashLength {
+			return fmt.Errorf("invalid state root %s", root)
+		}
+	}
+
+	return nil
+}
+
+// collectRoots returns the state roots of the genesis and the retained most recent blocks
+func (p *pruneParams) collectRoots(logger hclog.Logger) ([]types.Hash, error) {
+	db, err := server.NewBlockchainStorage(
+		server.DBBackend(p.dbBackend), filepath.Join(p.dataDir, "blockchain"), logger)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open blockchain storage: %w", err)
+	}
+	defer db.Close()
+
+	head, ok := db.ReadHeadNumber()
+	if !ok {
+		return nil, errHeadNotFound
+	}
+
+	p.head = head
+
+	roots := make([]types.Hash, 0, p.retention+uint64(len(p.keepRoots))+1)
+	for _, root := range p.keepRoots {
+		roots = append(roots, types.StringToHash(root))
+	}
+
+	from := uint64(0)
+	if head >= p.retention {
+		from = head - p.retention + 1
+	}
+
+	// the genesis state is always retained
+	numbers := []uint64{0}
+	for num := from; num <= head; num++ {
+		numbers = append(numbers, num)
+	}
+
+	for _, num := range numbers {
+		hash, ok := db.ReadCanonicalHash(num)
+		if !ok {
+			return nil, fmt.Errorf("canonical hash of block %d not found", num)
+		}
+
+		header, err := db.ReadHeader(hash)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read header of block %d: %w", num, err)
+		}
+
+		roots = append(roots, header.StateRoot)
+	}
+
+	return roots, nil
+}
+
+func (p *pruneParams) pruneState() error {
+	logger := hclog.New(&hclog.LoggerOptions{
+		Name:  "prune",
+		Level: hclog.LevelFromString("INFO"),
+	})
+
+	roots, err := p.collectRoots(logger)
+	if err != nil {
+		return err
+	}
+
+	storage, err := server.NewStateStorage(server.DBBackend(p.dbBackend), filepath.Join(p.dataDir, "trie"), logger)
+	if err != nil {
+		return fmt.Errorf("failed to open trie storage: %w", err)
+	}
+	defer storage.Close()
+
+	kv, ok := storage.(compactableStorage)
+	if !ok {
+		return itrie.ErrPruningNotSupported
+	}
+
+	if p.result, err = itrie.Prune(kv, roots); err != nil {
+		return err
+	}
+
+	return kv.Compact()
+}
+
+func (p *pruneParams) getResult() command.CommandResult {
+	return &PruneResult{
+		Head:      p.head,
+		Retention: p.retention,
+		Retained:  p.result.Retained,
+		Pruned:    p.result.Pruned,
+	}
+}
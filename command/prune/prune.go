@@ -0,0 +1,73 @@
+package prune
+
+import (
+	"fmt"
+
+	"github.com/spf13/cobra"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/0xPolygon/polygon-edge/server"
+)
+
+func GetCommand() *cobra.Command {
+	pruneCmd := &cobra.Command{
+		Use: "prune",
+		Short: "Removes the state of all the blocks except the genesis and the most recent ones " +
+			"from the data directory of a stopped node",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(pruneCmd)
+	helper.SetRequiredFlags(pruneCmd, params.getRequiredFlags())
+
+	return pruneCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.dataDir,
+		dataDirFlag,
+		"",
+		"the data directory of the node",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.retention,
+		retentionFlag,
+		server.MinStateRetention,
+		"number of the most recent block states to retain",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.keepRoots,
+		keepRootFlag,
+		nil,
+		"additional state roots to retain (e.g. the initial trie root of a regenesis)",
+	)
+
+	cmd.Flags().StringVar(
+		&params.dbBackend,
+		dbBackendFlag,
+		string(server.LevelDBBackend),
+		fmt.Sprintf("the database of the node: %s or %s", server.LevelDBBackend, server.PebbleBackend),
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	if err := params.pruneState(); err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	outputter.SetCommandResult(params.getResult())
+}
@@ -0,0 +1,30 @@
+package prune
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type PruneResult struct {
+	Head      uint64 `json:"head"`
+	Retention uint64 `json:"retention"`
+	Retained  int    `json:"retained"`
+	Pruned    int    `json:"pruned"`
+}
+
+func (r *PruneResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[PRUNE]\n")
+	buffer.WriteString("Pruned the state successfully:\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Head|%d", r.Head),
+		fmt.Sprintf("Retention|%d", r.Retention),
+		fmt.Sprintf("Retained entries|%d", r.Retained),
+		fmt.Sprintf("Pruned entries|%d", r.Pruned),
+	}))
+
+	return buffer.String()
+}
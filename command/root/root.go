@@ -8,11 +8,13 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/command/backup"
 	"github.com/0xPolygon/polygon-edge/command/bridge"
+	"github.com/0xPolygon/polygon-edge/command/db"
 	"github.com/0xPolygon/polygon-edge/command/genesis"
 	"github.com/0xPolygon/polygon-edge/command/helper"
 	"github.com/0xPolygon/polygon-edge/command/mint"
 	"github.com/0xPolygon/polygon-edge/command/monitor"
 	"github.com/0xPolygon/polygon-edge/command/peers"
+	"github.com/0xPolygon/polygon-edge/command/prune"
 	"github.com/0xPolygon/polygon-edge/command/regenesis"
 	"github.com/0xPolygon/polygon-edge/command/secrets"
 	polybftsecrets "github.com/0xPolygon/polygon-edge/command/secrets/init"
@@ -57,6 +59,8 @@ func (rc *RootCommand) registerSubCommands() {
 		regenesis.GetCommand(),
 		mint.GetCommand(),
 		validator.GetCommand(),
+		prune.GetCommand(),
+		db.GetCommand(),
 	)
 }
 
@@ -30,6 +30,7 @@ type Config struct {
 	LogFilePath              string     `json:"log_to" yaml:"log_to"`
 	JSONRPCBatchRequestLimit uint64     `json:"json_rpc_batch_request_limit" yaml:"json_rpc_batch_request_limit"`
 	JSONRPCBlockRangeLimit   uint64     `json:"json_rpc_block_range_limit" yaml:"json_rpc_block_range_limit"`
+	JSONRPCTxPoolAdmin       bool       `json:"json_rpc_txpool_admin" yaml:"json_rpc_txpool_admin"`
 	JSONLogFormat            bool       `json:"json_log_format" yaml:"json_log_format"`
 	CorsAllowedOrigins       []string   `json:"cors_allowed_origins" yaml:"cors_allowed_origins"`
 	TLSCertFile              string     `json:"tls_cert_file" yaml:"tls_cert_file"`
@@ -43,6 +44,14 @@ type Config struct {
 	MetricsInterval time.Duration `json:"metrics_interval" yaml:"metrics_interval"`
 
 	EventTracker *EventTracker `json:"event_tracker" yaml:"event_tracker"`
+
+	StateRetention uint64 `json:"state_retention" yaml:"state_retention"`
+
+	FlatSnapshot bool `json:"flat_snapshot" yaml:"flat_snapshot"`
+
+	SyncMode string `json:"sync_mode" yaml:"sync_mode"`
+
+	DBBackend string `json:"db_backend" yaml:"db_backend"`
 }
 
 // Telemetry holds the config details for metric services.
@@ -63,9 +72,14 @@ type Network struct {
 
 // TxPool defines the TxPool configuration params
 type TxPool struct {
-	PriceLimit         uint64 `json:"price_limit" yaml:"price_limit"`
-	MaxSlots           uint64 `json:"max_slots" yaml:"max_slots"`
-	MaxAccountEnqueued uint64 `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	PriceLimit         uint64        `json:"price_limit" yaml:"price_limit"`
+	MaxSlots           uint64        `json:"max_slots" yaml:"max_slots"`
+	MaxAccountEnqueued uint64        `json:"max_account_enqueued" yaml:"max_account_enqueued"`
+	Journal            string        `json:"journal" yaml:"journal"`
+	RejournalInterval  time.Duration `json:"rejournal_interval" yaml:"rejournal_interval"`
+	PriorityAccounts   []string      `json:"priority_accounts" yaml:"priority_accounts"`
+	SenderRateLimit    uint64        `json:"sender_rate_limit" yaml:"sender_rate_limit"`
+	PeerRateLimit      uint64        `json:"peer_rate_limit" yaml:"peer_rate_limit"`
 }
 
 // Headers defines the HTTP response headers required to enable CORS.
@@ -100,6 +114,19 @@ const (
 	// A value of 0 means the metrics are disabled.
 	DefaultMetricsInterval time.Duration = time.Second * 8
 
+	// DefaultSyncMode specifies how the node syncs with its peers,
+	// the full sync executes all the blocks from the genesis
+	DefaultSyncMode = "full"
+
+	// DefaultDBBackend specifies the database of the blockchain and state storages
+	DefaultDBBackend = "leveldb"
+
+	// DefaultTxPoolJournal specifies the file of the local transactions journal, relative to the data directory
+	DefaultTxPoolJournal = "txpool.journal"
+
+	// DefaultTxPoolRejournalInterval specifies the time interval after which the journal is regenerated
+	DefaultTxPoolRejournalInterval time.Duration = time.Hour
+
 	// event tracker
 
 	// DefaultNumBlockConfirmations minimal number of child blocks required for the parent block
@@ -139,6 +166,8 @@ func DefaultConfig() *Config {
 			PriceLimit:         0,
 			MaxSlots:           4096,
 			MaxAccountEnqueued: 128,
+			Journal:            DefaultTxPoolJournal,
+			RejournalInterval:  DefaultTxPoolRejournalInterval,
 		},
 		LogLevel:    "INFO",
 		RestoreFile: "",
@@ -154,6 +183,9 @@ func DefaultConfig() *Config {
 		ConcurrentRequestsDebug:  DefaultConcurrentRequestsDebug,
 		WebSocketReadLimit:       DefaultWebSocketReadLimit,
 		MetricsInterval:          DefaultMetricsInterval,
+		FlatSnapshot:             true,
+		SyncMode:                 DefaultSyncMode,
+		DBBackend:                DefaultDBBackend,
 		EventTracker: &EventTracker{
 			SyncBatchSize:          DefaultSyncBatchSize,
 			NumBlockConfirmations:  DefaultNumBlockConfirmations,
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math"
 	"net"
+	"strings"
 
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 
@@ -16,10 +17,17 @@ import (
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 var (
 	errDataDirectoryUndefined = errors.New("data directory not defined")
+	errStateRetentionTooLow   = fmt.Errorf("state retention must be zero or at least %d", server.MinStateRetention)
+	errInvalidSyncMode        = fmt.Errorf("sync mode must be either %s or %s", syncer.FullSyncMode, syncer.StateSyncMode)
+	errInvalidDBBackend       = fmt.Errorf("database backend must be either %s or %s",
+		server.LevelDBBackend, server.PebbleBackend)
+	errInvalidRejournalInterval = errors.New("txpool rejournal interval must be greater than 0")
 )
 
 func (p *serverParams) initConfigFromFile() error {
@@ -49,6 +57,26 @@ func (p *serverParams) initRawParams() error {
 		return err
 	}
 
+	if err := p.initStateRetention(); err != nil {
+		return err
+	}
+
+	if err := p.initSyncMode(); err != nil {
+		return err
+	}
+
+	if err := p.initDBBackend(); err != nil {
+		return err
+	}
+
+	if err := p.initTxPoolJournal(); err != nil {
+		return err
+	}
+
+	if err := p.initTxPoolPriorityAccounts(); err != nil {
+		return err
+	}
+
 	if p.isDevMode {
 		p.initDevMode()
 	}
@@ -69,6 +97,55 @@ func (p *serverParams) initDataDirLocation() error {
 	return nil
 }
 
+func (p *serverParams) initStateRetention() error {
+	if p.rawConfig.StateRetention != 0 && p.rawConfig.StateRetention < server.MinStateRetention {
+		return errStateRetentionTooLow
+	}
+
+	return nil
+}
+
+func (p *serverParams) initSyncMode() error {
+	switch syncer.SyncMode(p.rawConfig.SyncMode) {
+	case syncer.FullSyncMode, syncer.StateSyncMode:
+		return nil
+	default:
+		return errInvalidSyncMode
+	}
+}
+
+func (p *serverParams) initDBBackend() error {
+	switch server.DBBackend(p.rawConfig.DBBackend) {
+	case server.LevelDBBackend, server.PebbleBackend:
+		return nil
+	default:
+		return errInvalidDBBackend
+	}
+}
+
+func (p *serverParams) initTxPoolJournal() error {
+	if p.rawConfig.TxPool.Journal != "" && p.rawConfig.TxPool.RejournalInterval <= 0 {
+		return errInvalidRejournalInterval
+	}
+
+	return nil
+}
+
+func (p *serverParams) initTxPoolPriorityAccounts() error {
+	p.txPoolPriorityAccounts = make([]types.Address, 0, len(p.rawConfig.TxPool.PriorityAccounts))
+
+	for _, rawAddr := range p.rawConfig.TxPool.PriorityAccounts {
+		addr, err := types.IsValidAddress(strings.TrimSpace(rawAddr), false)
+		if err != nil {
+			return fmt.Errorf("invalid txpool priority account: %w", err)
+		}
+
+		p.txPoolPriorityAccounts = append(p.txPoolPriorityAccounts, addr)
+	}
+
+	return nil
+}
+
 func (p *serverParams) initLogFileLocation() {
 	if p.isLogFileLocationSet() {
 		p.logFileLocation = p.rawConfig.LogFilePath
@@ -9,6 +9,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/multiformats/go-multiaddr"
 )
@@ -28,8 +30,14 @@ const (
 	priceLimitFlag               = "price-limit"
 	jsonRPCBatchRequestLimitFlag = "json-rpc-batch-request-limit"
 	jsonRPCBlockRangeLimitFlag   = "json-rpc-block-range-limit"
+	jsonRPCTxPoolAdminFlag       = "json-rpc-txpool-admin"
 	maxSlotsFlag                 = "max-slots"
 	maxEnqueuedFlag              = "max-enqueued"
+	txPoolJournalFlag            = "txpool-journal"
+	txPoolRejournalFlag          = "txpool-rejournal"
+	txPoolPriorityAccountsFlag   = "txpool-priority-accounts"
+	txPoolSenderRateLimitFlag    = "txpool-sender-rate-limit"
+	txPoolPeerRateLimitFlag      = "txpool-peer-rate-limit"
 	blockGasTargetFlag           = "block-gas-target"
 	secretsConfigFlag            = "secrets-config"
 	restoreFlag                  = "restore"
@@ -47,6 +55,13 @@ const (
 
 	metricsIntervalFlag = "metrics-interval"
 
+	stateRetentionFlag = "state-retention"
+	flatSnapshotFlag   = "flat-snapshot"
+
+	syncModeFlag = "sync-mode"
+
+	dbBackendFlag = "db-backend"
+
 	// event tracker
 	trackerSyncBatchSizeFlag          = "sync-batch-size"
 	trackerNumBlockConfirmationsFlag  = "num-block-confirmations"
@@ -93,6 +108,8 @@ type serverParams struct {
 	logFileLocation string
 
 	relayer bool
+
+	txPoolPriorityAccounts []types.Address
 }
 
 func (p *serverParams) isMaxPeersSet() bool {
@@ -156,6 +173,7 @@ func (p *serverParams) generateConfig() *server.Config {
 			AccessControlAllowOrigin: p.rawConfig.CorsAllowedOrigins,
 			BatchLengthLimit:         p.rawConfig.JSONRPCBatchRequestLimit,
 			BlockRangeLimit:          p.rawConfig.JSONRPCBlockRangeLimit,
+			TxPoolAdmin:              p.rawConfig.JSONRPCTxPoolAdmin,
 			ConcurrentRequestsDebug:  p.rawConfig.ConcurrentRequestsDebug,
 			WebSocketReadLimit:       p.rawConfig.WebSocketReadLimit,
 		},
@@ -180,6 +198,11 @@ func (p *serverParams) generateConfig() *server.Config {
 		PriceLimit:         p.rawConfig.TxPool.PriceLimit,
 		MaxSlots:           p.rawConfig.TxPool.MaxSlots,
 		MaxAccountEnqueued: p.rawConfig.TxPool.MaxAccountEnqueued,
+		TxPoolJournal:      p.rawConfig.TxPool.Journal,
+		TxPoolRejournal:    p.rawConfig.TxPool.RejournalInterval,
+		TxPoolPriority:     p.txPoolPriorityAccounts,
+		TxPoolSenderRate:   p.rawConfig.TxPool.SenderRateLimit,
+		TxPoolPeerRate:     p.rawConfig.TxPool.PeerRateLimit,
 		SecretsManager:     p.secretsConfig,
 		RestoreFile:        p.getRestoreFilePath(),
 		LogLevel:           hclog.LevelFromString(p.rawConfig.LogLevel),
@@ -190,6 +213,10 @@ func (p *serverParams) generateConfig() *server.Config {
 
 		Relayer:         p.relayer,
 		MetricsInterval: p.rawConfig.MetricsInterval,
+		StateRetention:  p.rawConfig.StateRetention,
+		FlatSnapshot:    p.rawConfig.FlatSnapshot,
+		SyncMode:        syncer.SyncMode(p.rawConfig.SyncMode),
+		DBBackend:       server.DBBackend(p.rawConfig.DBBackend),
 		EventTracker: &server.EventTracker{
 			SyncBatchSize:          p.rawConfig.EventTracker.SyncBatchSize,
 			NumBlockConfirmations:  p.rawConfig.EventTracker.NumBlockConfirmations,
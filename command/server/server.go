@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/command/server/config"
 	"github.com/0xPolygon/polygon-edge/command/server/export"
 	"github.com/0xPolygon/polygon-edge/server"
+	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/spf13/cobra"
 )
 
@@ -185,6 +186,45 @@ func setFlags(cmd *cobra.Command) {
 		"maximum number of enqueued transactions per account",
 	)
 
+	cmd.Flags().StringVar(
+		&params.rawConfig.TxPool.Journal,
+		txPoolJournalFlag,
+		defaultConfig.TxPool.Journal,
+		"the file (relative to the data directory) where the locally submitted transactions are kept "+
+			"across the node restarts, an empty value disables it",
+	)
+
+	cmd.Flags().DurationVar(
+		&params.rawConfig.TxPool.RejournalInterval,
+		txPoolRejournalFlag,
+		defaultConfig.TxPool.RejournalInterval,
+		"the time interval after which the local transactions journal is regenerated",
+	)
+
+	cmd.Flags().StringSliceVar(
+		&params.rawConfig.TxPool.PriorityAccounts,
+		txPoolPriorityAccountsFlag,
+		defaultConfig.TxPool.PriorityAccounts,
+		"the accounts whose transactions are exempt from the price limit, the enqueued limit "+
+			"and the eviction, and are included in the blocks before the others",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.SenderRateLimit,
+		txPoolSenderRateLimitFlag,
+		defaultConfig.TxPool.SenderRateLimit,
+		"the number of gossiped transactions per second accepted into the txpool from a single sender, "+
+			"value of 0 disables it",
+	)
+
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.TxPool.PeerRateLimit,
+		txPoolPeerRateLimitFlag,
+		defaultConfig.TxPool.PeerRateLimit,
+		"the number of transaction gossip messages per second accepted into the txpool from a single peer "+
+			"(the announcements it delivers and the full transactions it publishes), value of 0 disables it",
+	)
+
 	cmd.Flags().StringArrayVar(
 		&params.rawConfig.CorsAllowedOrigins,
 		corsOriginFlag,
@@ -207,6 +247,14 @@ func setFlags(cmd *cobra.Command) {
 			"that consider fromBlock/toBlock values (e.g. eth_getLogs), value of 0 disables it",
 	)
 
+	cmd.Flags().BoolVar(
+		&params.rawConfig.JSONRPCTxPoolAdmin,
+		jsonRPCTxPoolAdminFlag,
+		defaultConfig.JSONRPCTxPoolAdmin,
+		"enable the json-rpc txpooladmin methods modifying the pool "+
+			"(txpooladmin_removeTransaction, txpooladmin_dropAccount)",
+	)
+
 	cmd.Flags().StringVar(
 		&params.rawConfig.LogFilePath,
 		logFileLocationFlag,
@@ -256,6 +304,42 @@ func setFlags(cmd *cobra.Command) {
 		"the interval (in seconds) at which special metrics are generated. a value of zero means the metrics are disabled",
 	)
 
+	cmd.Flags().Uint64Var(
+		&params.rawConfig.StateRetention,
+		stateRetentionFlag,
+		defaultConfig.StateRetention,
+		fmt.Sprintf("number of the most recent block states to retain when pruning the state trie "+
+			"(at least %d), a value of zero keeps the full archive of the states", server.MinStateRetention),
+	)
+
+	cmd.Flags().BoolVar(
+		&params.rawConfig.FlatSnapshot,
+		flatSnapshotFlag,
+		defaultConfig.FlatSnapshot,
+		"serve the account and storage reads of the recent states from the flat state snapshot, "+
+			"which is generated in the background if it is missing or outdated",
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.SyncMode,
+		syncModeFlag,
+		defaultConfig.SyncMode,
+		fmt.Sprintf("the mode of syncing with the peers: %s executes all the blocks from the genesis, "+
+			"%s downloads the state of a recent block and executes only the following blocks "+
+			"(the receipts and the bridge events of the preceding blocks are not processed, so the json-rpc "+
+			"receipt and log queries for those blocks fail)",
+			syncer.FullSyncMode, syncer.StateSyncMode),
+	)
+
+	cmd.Flags().StringVar(
+		&params.rawConfig.DBBackend,
+		dbBackendFlag,
+		defaultConfig.DBBackend,
+		fmt.Sprintf("the database of the blockchain and state storages: %s or %s "+
+			"(an existing data directory is converted by the db migrate command)",
+			server.LevelDBBackend, server.PebbleBackend),
+	)
+
 	{ // event tracker
 		cmd.Flags().Uint64Var(
 			&params.rawConfig.EventTracker.SyncBatchSize,
@@ -0,0 +1,29 @@
+package txstatus
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	params = &txStatusParams{}
+)
+
+const (
+	hashFlag = "hash"
+)
+
+type txStatusParams struct {
+	hashRaw string
+}
+
+func (p *txStatusParams) getRequiredFlags() []string {
+	return []string{
+		hashFlag,
+	}
+}
+
+func (p *txStatusParams) validateFlags() error {
+	hash := types.Hash{}
+
+	return hash.UnmarshalText([]byte(p.hashRaw))
+}
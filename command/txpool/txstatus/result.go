@@ -0,0 +1,48 @@
+package txstatus
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/command/helper"
+)
+
+type TxStatusEvent struct {
+	Status string `json:"status"`
+	Reason string `json:"reason,omitempty"`
+	Time   string `json:"time"`
+}
+
+type TxPoolTxStatusResult struct {
+	Hash      string          `json:"hash"`
+	InPool    bool            `json:"in_pool"`
+	Demotions uint64          `json:"demotions"`
+	NonceGap  uint64          `json:"nonce_gap"`
+	Events    []TxStatusEvent `json:"events"`
+}
+
+func (r *TxPoolTxStatusResult) GetOutput() string {
+	var buffer bytes.Buffer
+
+	buffer.WriteString("\n[TXPOOL TX STATUS]\n")
+	buffer.WriteString(helper.FormatKV([]string{
+		fmt.Sprintf("Hash|%s", r.Hash),
+		fmt.Sprintf("In pool|%t", r.InPool),
+		fmt.Sprintf("Demotions|%d", r.Demotions),
+		fmt.Sprintf("Nonce gap|%d", r.NonceGap),
+	}))
+
+	if len(r.Events) > 0 {
+		events := make([]string, len(r.Events))
+		for i, event := range r.Events {
+			events[i] = fmt.Sprintf("%s|%s|%s", event.Time, event.Status, event.Reason)
+		}
+
+		buffer.WriteString("\n\n[HISTORY]\n")
+		buffer.WriteString(helper.FormatList(events))
+	}
+
+	buffer.WriteString("\n")
+
+	return buffer.String()
+}
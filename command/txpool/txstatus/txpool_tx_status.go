@@ -0,0 +1,79 @@
+package txstatus
+
+import (
+	"context"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/command"
+	"github.com/0xPolygon/polygon-edge/command/helper"
+	"github.com/spf13/cobra"
+
+	txpoolOp "github.com/0xPolygon/polygon-edge/txpool/proto"
+)
+
+func GetCommand() *cobra.Command {
+	txStatusCmd := &cobra.Command{
+		Use:     "tx-status",
+		Short:   "Returns the lifecycle of the transaction in the transaction pool",
+		PreRunE: runPreRun,
+		Run:     runCommand,
+	}
+
+	setFlags(txStatusCmd)
+	helper.SetRequiredFlags(txStatusCmd, params.getRequiredFlags())
+
+	return txStatusCmd
+}
+
+func setFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&params.hashRaw,
+		hashFlag,
+		"",
+		"the hash of the transaction",
+	)
+}
+
+func runPreRun(_ *cobra.Command, _ []string) error {
+	return params.validateFlags()
+}
+
+func runCommand(cmd *cobra.Command, _ []string) {
+	outputter := command.InitializeOutputter(cmd)
+	defer outputter.WriteOutput()
+
+	txStatusResponse, err := getTxStatus(helper.GetGRPCAddress(cmd))
+	if err != nil {
+		outputter.SetError(err)
+
+		return
+	}
+
+	events := make([]TxStatusEvent, len(txStatusResponse.Events))
+	for i, event := range txStatusResponse.Events {
+		events[i] = TxStatusEvent{
+			Status: event.Type.String(),
+			Reason: event.Reason,
+			Time:   time.UnixMilli(event.Timestamp).UTC().Format(time.RFC3339Nano),
+		}
+	}
+
+	outputter.SetCommandResult(&TxPoolTxStatusResult{
+		Hash:      params.hashRaw,
+		InPool:    txStatusResponse.InPool,
+		Demotions: txStatusResponse.Demotions,
+		NonceGap:  txStatusResponse.NonceGap,
+		Events:    events,
+	})
+}
+
+func getTxStatus(grpcAddress string) (*txpoolOp.TxStatusResp, error) {
+	client, err := helper.GetTxPoolClientConnection(
+		grpcAddress,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return client.TxStatus(context.Background(), &txpoolOp.TxStatusReq{TxHash: params.hashRaw})
+}
@@ -11,6 +11,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
 	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/syncer"
 	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
@@ -89,6 +91,11 @@ type Params struct {
 
 	MetricsInterval time.Duration
 
+	// SyncMode defines how the node syncs with its peers
+	SyncMode syncer.SyncMode
+	// StateStorage is the storage of the state trie, which is served to the syncing peers
+	StateStorage itrie.Storage
+
 	// event tracker
 	EventTracker *EventTracker
 }
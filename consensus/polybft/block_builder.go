@@ -1,6 +1,7 @@
 package polybft
 
 import (
+	"errors"
 	"fmt"
 	"time"
 
@@ -39,6 +40,9 @@ type BlockBuilderParams struct {
 
 	// BaseFee is the base fee
 	BaseFee uint64
+
+	// Ordering is the transactions ordering policy, it limits the transactions of a single sender
+	Ordering txpool.OrderingPolicy
 }
 
 func NewBlockBuilder(params *BlockBuilderParams) *BlockBuilder {
@@ -155,10 +159,41 @@ func (b *BlockBuilder) WriteTx(tx *types.Transaction) error {
 	return nil
 }
 
-// Fill fills the block with transactions from the txpool
+// WriteBundle applies the bundle transactions to the state atomically,
+// if any of them fails the state is reverted and none of them is included in the block
+func (b *BlockBuilder) WriteBundle(bundle *types.Bundle) error {
+	for _, tx := range bundle.Txs {
+		if tx.Gas() > b.params.GasLimit {
+			return txpool.ErrBlockLimitExceeded
+		}
+	}
+
+	if err := b.state.WriteBundle(bundle.Txs); err != nil {
+		return err
+	}
+
+	b.txns = append(b.txns, bundle.Txs...)
+
+	return nil
+}
+
+// Fill fills the block with the bundles targeting the block first, and then with transactions from the txpool
 func (b *BlockBuilder) Fill() {
 	blockTimer := time.NewTimer(b.params.BlockTime)
 
+	for _, bundle := range b.params.TxPool.Bundles(b.header.Number, b.header.Timestamp) {
+		if err := b.WriteBundle(bundle); err != nil {
+			b.params.Logger.Debug("Bundle not included", "hash", bundle.Hash(), "err", err)
+		}
+	}
+
+	var maxSenderTxs uint64
+	if b.params.Ordering != nil {
+		maxSenderTxs = b.params.Ordering.MaxSenderTxs()
+	}
+
+	senderTxs := make(map[types.Address]uint64)
+
 	b.params.TxPool.Prepare()
 write:
 	for {
@@ -168,10 +203,17 @@ write:
 		default:
 			tx := b.params.TxPool.Peek()
 
+			// the sender reached its limit, so its transactions are left in the pool for the next blocks
+			if tx != nil && maxSenderTxs > 0 && senderTxs[tx.From()] >= maxSenderTxs {
+				continue
+			}
+
 			// execute transactions one by one
 			finished, err := b.writeTxPoolTransaction(tx)
 			if err != nil {
 				b.params.Logger.Debug("Fill transaction error", "hash", tx.Hash(), "err", err)
+			} else if tx != nil {
+				senderTxs[tx.From()]++
 			}
 
 			if finished {
@@ -194,6 +236,17 @@ func (b *BlockBuilder) writeTxPoolTransaction(tx *types.Transaction) (bool, erro
 		return true, nil
 	}
 
+	if conditions := b.params.TxPool.TxConditions(tx.Hash()); conditions != nil {
+		if err := conditions.Check(b.header.Number, b.header.Timestamp, b.state.GetStorage); err != nil {
+			// the transaction is left in the pool until its block range is reached
+			if !errors.Is(err, types.ErrTxConditionsPremature) {
+				b.params.TxPool.RemoveConditionalTx(tx.Hash())
+			}
+
+			return false, err
+		}
+	}
+
 	if err := b.WriteTx(tx); err != nil {
 		if _, ok := err.(*state.GasLimitReachedTransitionApplicationError); ok { //nolint:errorlint
 			// stop processing
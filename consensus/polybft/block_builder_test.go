@@ -11,9 +11,11 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/common"
 	"github.com/0xPolygon/polygon-edge/state"
 	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"github.com/umbracle/ethgo"
 )
@@ -84,7 +86,9 @@ func TestBlockBuilder_BuildBlockTxOneFailedTxAndOneTakesTooMuchGas(t *testing.T)
 	parentHeader := &types.Header{StateRoot: hash, GasLimit: 1e15}
 
 	txPool := &txPoolMock{}
+	txPool.On("Bundles", uint64(1), mock.Anything).Return([]*types.Bundle(nil)).Once()
 	txPool.On("Prepare").Once()
+	txPool.On("TxConditions", mock.Anything).Return((*types.TxConditions)(nil))
 
 	for i, acc := range accounts {
 		gas := uint64(gasLimit)
@@ -165,3 +169,262 @@ func TestBlockBuilder_BuildBlockTxOneFailedTxAndOneTakesTooMuchGas(t *testing.T)
 	assert.False(t, logsBloom.IsLogInBloom(
 		&types.Log{Address: types.StringToAddress("111177779999")}))
 }
+
+func TestBlockBuilder_FillWithBundles(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	keys := [3]*ecdsa.PrivateKey{}
+	addrs := [3]types.Address{}
+
+	for i := range keys {
+		key, err := crypto.GenerateECDSAKey()
+		require.NoError(t, err)
+
+		keys[i], addrs[i] = key, crypto.PubKeyToAddress(&key.PublicKey)
+	}
+
+	forks := &chain.Forks{}
+	logger := hclog.NewNullLogger()
+	signer := crypto.NewSigner(forks.At(0), chainID)
+
+	executor := state.NewExecutor(&chain.Params{ChainID: chainID, Forks: forks},
+		itrie.NewState(itrie.NewMemoryStorage()), logger)
+	executor.GetHash = func(header *types.Header) func(i uint64) types.Hash {
+		return func(i uint64) (res types.Hash) {
+			return types.BytesToHash(common.EncodeUint64ToBytes(i))
+		}
+	}
+
+	// the last account has no funds
+	stateRoot, err := executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		addrs[0]: {Balance: ethgo.Ether(1)},
+		addrs[1]: {Balance: ethgo.Ether(1)},
+	}, types.ZeroHash)
+	require.NoError(t, err)
+
+	newTx := func(sender int, nonce uint64) *types.Transaction {
+		tx, err := signer.SignTx(types.NewTx(types.NewLegacyTx(
+			types.WithGasPrice(big.NewInt(1_000)),
+			types.WithValue(big.NewInt(1_000)),
+			types.WithGas(21_000),
+			types.WithNonce(nonce),
+			types.WithTo(&types.ZeroAddress),
+		)), keys[sender])
+		require.NoError(t, err)
+
+		return tx
+	}
+
+	succeeding := &types.Bundle{BlockNumber: 1, Txs: []*types.Transaction{newTx(0, 0), newTx(0, 1)}}
+	failing := &types.Bundle{BlockNumber: 1, Txs: []*types.Transaction{newTx(1, 0), newTx(2, 0)}}
+
+	txPool := &txPoolMock{}
+	txPool.On("Bundles", uint64(1), mock.Anything).Return([]*types.Bundle{failing, succeeding}).Once()
+	txPool.On("Prepare").Once()
+	txPool.On("Peek").Return((*types.Transaction)(nil)).Once()
+
+	bb := NewBlockBuilder(&BlockBuilderParams{
+		BlockTime: time.Millisecond * 100,
+		Parent:    &types.Header{StateRoot: stateRoot, GasLimit: 1e15},
+		Executor:  executor,
+		GasLimit:  21_000 * 10,
+		TxPool:    txPool,
+		Logger:    logger,
+	})
+
+	require.NoError(t, bb.Reset())
+
+	bb.Fill()
+
+	txPool.AssertExpectations(t)
+	require.Equal(t, succeeding.Txs, bb.txns)
+	require.Len(t, bb.Receipts(), 2)
+	require.Equal(t, uint64(2), bb.GetState().GetNonce(addrs[0]))
+	require.Equal(t, uint64(0), bb.GetState().GetNonce(addrs[1]))
+}
+
+func TestBlockBuilder_FillWithSenderLimit(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	keys := [2]*ecdsa.PrivateKey{}
+	addrs := [2]types.Address{}
+
+	for i := range keys {
+		key, err := crypto.GenerateECDSAKey()
+		require.NoError(t, err)
+
+		keys[i], addrs[i] = key, crypto.PubKeyToAddress(&key.PublicKey)
+	}
+
+	forks := &chain.Forks{}
+	logger := hclog.NewNullLogger()
+	signer := crypto.NewSigner(forks.At(0), chainID)
+
+	executor := state.NewExecutor(&chain.Params{ChainID: chainID, Forks: forks},
+		itrie.NewState(itrie.NewMemoryStorage()), logger)
+	executor.GetHash = func(header *types.Header) func(i uint64) types.Hash {
+		return func(i uint64) (res types.Hash) {
+			return types.BytesToHash(common.EncodeUint64ToBytes(i))
+		}
+	}
+
+	stateRoot, err := executor.WriteGenesis(map[types.Address]*chain.GenesisAccount{
+		addrs[0]: {Balance: ethgo.Ether(1)},
+		addrs[1]: {Balance: ethgo.Ether(1)},
+	}, types.ZeroHash)
+	require.NoError(t, err)
+
+	newTx := func(sender int, nonce uint64) *types.Transaction {
+		tx, err := signer.SignTx(types.NewTx(types.NewLegacyTx(
+			types.WithGasPrice(big.NewInt(1_000)),
+			types.WithValue(big.NewInt(1_000)),
+			types.WithGas(21_000),
+			types.WithNonce(nonce),
+			types.WithTo(&types.ZeroAddress),
+		)), keys[sender])
+		require.NoError(t, err)
+
+		tx.SetFrom(addrs[sender])
+
+		return tx
+	}
+
+	first, capped, other := newTx(0, 0), newTx(0, 1), newTx(1, 0)
+
+	ordering, err := txpool.NewOrderingPolicy(txpool.FeeOrdering, 1)
+	require.NoError(t, err)
+
+	txPool := &txPoolMock{}
+	txPool.On("Bundles", uint64(1), mock.Anything).Return([]*types.Bundle(nil)).Once()
+	txPool.On("Prepare").Once()
+	txPool.On("TxConditions", mock.Anything).Return((*types.TxConditions)(nil))
+	txPool.On("Peek").Return(first).Once()
+	txPool.On("Pop", first).Once()
+	txPool.On("Peek").Return(capped).Once()
+	txPool.On("Peek").Return(other).Once()
+	txPool.On("Pop", other).Once()
+	txPool.On("Peek").Return((*types.Transaction)(nil)).Once()
+
+	bb := NewBlockBuilder(&BlockBuilderParams{
+		BlockTime: time.Millisecond * 100,
+		Parent:    &types.Header{StateRoot: stateRoot, GasLimit: 1e15},
+		Executor:  executor,
+		GasLimit:  21_000 * 10,
+		TxPool:    txPool,
+		Logger:    logger,
+		Ordering:  ordering,
+	})
+
+	require.NoError(t, bb.Reset())
+
+	bb.Fill()
+
+	// the second transaction of the sender is left in the pool
+	txPool.AssertExpectations(t)
+	require.Equal(t, []*types.Transaction{first, other}, bb.txns)
+}
+
+func TestBlockBuilder_FillWithConditionalTxs(t *testing.T) {
+	t.Parallel()
+
+	const chainID = 100
+
+	keys := [3]*ecdsa.PrivateKey{}
+	addrs := [3]types.Address{}
+
+	for i := range keys {
+		key, err := crypto.GenerateECDSAKey()
+		require.NoError(t, err)
+
+		keys[i], addrs[i] = key, crypto.PubKeyToAddress(&key.PublicKey)
+	}
+
+	forks := &chain.Forks{}
+	logger := hclog.NewNullLogger()
+	signer := crypto.NewSigner(forks.At(0), chainID)
+
+	executor := state.NewExecutor(&chain.Params{ChainID: chainID, Forks: forks},
+		itrie.NewState(itrie.NewMemoryStorage()), logger)
+	executor.GetHash = func(header *types.Header) func(i uint64) types.Hash {
+		return func(i uint64) (res types.Hash) {
+			return types.BytesToHash(common.EncodeUint64ToBytes(i))
+		}
+	}
+
+	contract := types.StringToAddress("0x1001")
+	slot, value := types.StringToHash("0x1"), types.StringToHash("0x2")
+
+	genesis := map[types.Address]*chain.GenesisAccount{
+		contract: {Balance: big.NewInt(0), Code: []byte{0x0}, Storage: map[types.Hash]types.Hash{slot: value}},
+	}
+
+	for _, addr := range addrs {
+		genesis[addr] = &chain.GenesisAccount{Balance: ethgo.Ether(1)}
+	}
+
+	stateRoot, err := executor.WriteGenesis(genesis, types.ZeroHash)
+	require.NoError(t, err)
+
+	newTx := func(sender int, nonce uint64) *types.Transaction {
+		tx, err := signer.SignTx(types.NewTx(types.NewLegacyTx(
+			types.WithGasPrice(big.NewInt(1_000)),
+			types.WithValue(big.NewInt(1_000)),
+			types.WithGas(21_000),
+			types.WithNonce(nonce),
+			types.WithTo(&types.ZeroAddress),
+		)), keys[sender])
+		require.NoError(t, err)
+
+		return tx.ComputeHash()
+	}
+
+	valid, mismatched, premature := newTx(0, 0), newTx(1, 0), newTx(2, 0)
+
+	// the sender of the valid tx has a conditional tx failing as well
+	failed := newTx(0, 1)
+
+	txPool := &txPoolMock{}
+	txPool.On("Bundles", uint64(1), mock.Anything).Return([]*types.Bundle(nil)).Once()
+	txPool.On("Prepare").Once()
+	txPool.On("TxConditions", valid.Hash()).Return(&types.TxConditions{
+		KnownAccounts: map[types.Address]map[types.Hash]types.Hash{contract: {slot: value}},
+	})
+	txPool.On("TxConditions", mismatched.Hash()).Return(&types.TxConditions{
+		KnownAccounts: map[types.Address]map[types.Hash]types.Hash{contract: {slot: types.ZeroHash}},
+	})
+	txPool.On("TxConditions", premature.Hash()).Return(&types.TxConditions{BlockNumberMin: 2})
+	txPool.On("TxConditions", failed.Hash()).Return(&types.TxConditions{
+		KnownAccounts: map[types.Address]map[types.Hash]types.Hash{contract: {slot: types.ZeroHash}},
+	})
+	txPool.On("Peek").Return(mismatched).Once()
+	txPool.On("RemoveConditionalTx", mismatched.Hash()).Once()
+	txPool.On("Peek").Return(premature).Once()
+	txPool.On("Peek").Return(valid).Once()
+	txPool.On("Pop", valid).Once()
+	txPool.On("Peek").Return(failed).Once()
+	txPool.On("RemoveConditionalTx", failed.Hash()).Once()
+	txPool.On("Peek").Return((*types.Transaction)(nil)).Once()
+
+	bb := NewBlockBuilder(&BlockBuilderParams{
+		BlockTime: time.Millisecond * 100,
+		Parent:    &types.Header{StateRoot: stateRoot, GasLimit: 1e15},
+		Executor:  executor,
+		GasLimit:  21_000 * 10,
+		TxPool:    txPool,
+		Logger:    logger,
+	})
+
+	require.NoError(t, bb.Reset())
+
+	bb.Fill()
+
+	// the premature transaction is neither dropped nor included,
+	// and the failed ones are removed without dropping their accounts
+	txPool.AssertExpectations(t)
+	txPool.AssertNotCalled(t, "Drop", mock.Anything)
+	require.Equal(t, []*types.Transaction{valid}, bb.txns)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo"
 	"github.com/umbracle/ethgo/contract"
@@ -66,6 +67,9 @@ type blockchainBackend interface {
 
 	// GetReceiptsByHash retrieves receipts by hash
 	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
+
+	// FirstReceiptsNumber returns the number of the first block whose receipts are stored
+	FirstReceiptsNumber() uint64
 }
 
 var _ blockchainBackend = &blockchainWrapper{}
@@ -73,6 +77,7 @@ var _ blockchainBackend = &blockchainWrapper{}
 type blockchainWrapper struct {
 	executor   *state.Executor
 	blockchain *blockchain.Blockchain
+	ordering   txpool.OrderingPolicy
 }
 
 // CurrentHeader returns the header of blockchain block head
@@ -174,6 +179,7 @@ func (p *blockchainWrapper) NewBlockBuilder(
 		BaseFee:   p.blockchain.CalculateBaseFee(parent),
 		TxPool:    txPool,
 		Logger:    logger,
+		Ordering:  p.ordering,
 	}), nil
 }
 
@@ -198,6 +204,10 @@ func (p *blockchainWrapper) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt
 	return p.blockchain.GetReceiptsByHash(hash)
 }
 
+func (p *blockchainWrapper) FirstReceiptsNumber() uint64 {
+	return p.blockchain.FirstReceiptsNumber()
+}
+
 var _ contract.Provider = &stateProvider{}
 
 type stateProvider struct {
@@ -18,6 +18,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/wallet"
 	"github.com/0xPolygon/polygon-edge/contracts"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	bolt "go.etcd.io/bbolt"
 
@@ -48,6 +49,10 @@ type txPoolInterface interface {
 	Demote(*types.Transaction)
 	SetSealing(bool)
 	ResetWithHeaders(...*types.Header)
+	Bundles(number, timestamp uint64) []*types.Bundle
+	SetOrderingPolicy(txpool.OrderingPolicy)
+	TxConditions(types.Hash) *types.TxConditions
+	RemoveConditionalTx(types.Hash)
 }
 
 // epochMetadata is the static info for epoch currently being processed
@@ -8,6 +8,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/stretchr/testify/mock"
@@ -20,6 +21,8 @@ var _ blockchainBackend = (*blockchainMock)(nil)
 
 type blockchainMock struct {
 	mock.Mock
+
+	firstReceipts uint64
 }
 
 func (m *blockchainMock) CurrentHeader() *types.Header {
@@ -129,6 +132,10 @@ func (m *blockchainMock) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, e
 	return args.Get(0).([]*types.Receipt), args.Error(1)
 }
 
+func (m *blockchainMock) FirstReceiptsNumber() uint64 {
+	return m.firstReceipts
+}
+
 var _ polybftBackend = (*polybftBackendMock)(nil)
 
 type polybftBackendMock struct {
@@ -348,6 +355,10 @@ func (tp *txPoolMock) Drop(tx *types.Transaction) {
 	tp.Called(tx)
 }
 
+func (tp *txPoolMock) RemoveConditionalTx(hash types.Hash) {
+	tp.Called(hash)
+}
+
 func (tp *txPoolMock) Demote(tx *types.Transaction) {
 	tp.Called(tx)
 }
@@ -360,6 +371,22 @@ func (tp *txPoolMock) ResetWithHeaders(values ...*types.Header) {
 	tp.Called(values)
 }
 
+func (tp *txPoolMock) Bundles(number, timestamp uint64) []*types.Bundle {
+	args := tp.Called(number, timestamp)
+
+	return args[0].([]*types.Bundle) //nolint:forcetypeassert
+}
+
+func (tp *txPoolMock) SetOrderingPolicy(ordering txpool.OrderingPolicy) {
+	tp.Called(ordering)
+}
+
+func (tp *txPoolMock) TxConditions(hash types.Hash) *types.TxConditions {
+	args := tp.Called(hash)
+
+	return args[0].(*types.TxConditions) //nolint:forcetypeassert
+}
+
 var _ syncer.Syncer = (*syncerMock)(nil)
 
 type syncerMock struct {
@@ -463,12 +463,23 @@ func (p *Polybft) Initialize() error {
 		p.config.Network,
 		p.config.Blockchain,
 		time.Duration(p.config.BlockTime)*3*time.Second,
+		p.config.SyncMode,
+		p.config.StateStorage,
 	)
 
+	// set the transactions ordering policy used by both the txpool and the block builder
+	ordering, err := p.genesisClientConfig.TxOrdering.policy()
+	if err != nil {
+		return err
+	}
+
+	p.txPool.SetOrderingPolicy(ordering)
+
 	// set blockchain backend
 	p.blockchain = &blockchainWrapper{
 		blockchain: p.config.Blockchain,
 		executor:   p.config.Executor,
+		ordering:   ordering,
 	}
 
 	// create bridge and consensus topics
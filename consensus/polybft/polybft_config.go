@@ -11,6 +11,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/consensus/polybft/validator"
 	"github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/txpool"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -99,6 +100,9 @@ type PolyBFTConfig struct {
 
 	// StakeTokenAddr represents the stake token contract address
 	StakeTokenAddr types.Address `json:"stakeTokenAddr"`
+
+	// TxOrdering defines the order in which the block proposer includes the pool transactions
+	TxOrdering *TxOrderingConfig `json:"txOrdering,omitempty"`
 }
 
 // LoadPolyBFTConfig loads chain config from provided path and unmarshals PolyBFTConfig
@@ -388,3 +392,21 @@ type BurnContractInfo struct {
 	Address            types.Address
 	DestinationAddress types.Address
 }
+
+// TxOrderingConfig defines the transactions ordering policy of the block proposer
+type TxOrderingConfig struct {
+	// Policy is the name of the ordering policy (fee or fifo), the fee ordering is used if empty
+	Policy string `json:"policy"`
+
+	// MaxSenderTxs is the maximum number of transactions of a single sender in a block, zero means no limit
+	MaxSenderTxs uint64 `json:"maxSenderTxs"`
+}
+
+// policy returns the configured ordering policy, or the fee ordering if the config is not set
+func (t *TxOrderingConfig) policy() (txpool.OrderingPolicy, error) {
+	if t == nil {
+		return txpool.NewOrderingPolicy(txpool.FeeOrdering, 0)
+	}
+
+	return txpool.NewOrderingPolicy(t.Policy, t.MaxSenderTxs)
+}
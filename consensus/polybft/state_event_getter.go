@@ -1,12 +1,19 @@
 package polybft
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/umbracle/ethgo"
 	bolt "go.etcd.io/bbolt"
 )
 
+// errReceiptsNotStored is returned when the receipts of a block in the requested range
+// have not been stored, because the block was written by the state sync without being executed
+var errReceiptsNotStored = errors.New("receipts are not stored for the block")
+
 // EventSubscriber specifies functions needed for a component to subscribe to eventProvider
 type EventSubscriber interface {
 	// GetLogFilters returns a map of log filters for getting desired events,
@@ -152,6 +159,11 @@ type receiptsGetter struct {
 
 func (r *receiptsGetter) getReceiptsFromBlocksRange(from, to uint64,
 	receiptsHandler func(*types.Header, []*types.Receipt) error) error {
+	// blocks preceding the first receipts number have no receipts, so their events would be silently skipped
+	if first := r.blockchain.FirstReceiptsNumber(); from < first {
+		return fmt.Errorf("%w: block %d (first block with receipts is %d)", errReceiptsNotStored, from, first)
+	}
+
 	for i := from; i <= to; i++ {
 		blockHeader, found := r.blockchain.GetHeaderByNumber(i)
 		if !found {
@@ -0,0 +1,55 @@
+package polybft
+
+import (
+	"testing"
+
+	"github.com/0xPolygon/polygon-edge/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReceiptsGetter_GetReceiptsFromBlocksRange(t *testing.T) {
+	t.Parallel()
+
+	headers := map[uint64]*types.Header{}
+	for i := uint64(1); i <= 4; i++ {
+		headers[i] = &types.Header{Number: i, Hash: types.Hash{byte(i)}}
+	}
+
+	t.Run("blocks without stored receipts", func(t *testing.T) {
+		t.Parallel()
+
+		blockchainMock := &blockchainMock{firstReceipts: 3}
+
+		getter := &receiptsGetter{blockchain: blockchainMock}
+		err := getter.getReceiptsFromBlocksRange(1, 4, func(*types.Header, []*types.Receipt) error {
+			t.Fatal("handler must not be called for a range without receipts")
+
+			return nil
+		})
+
+		require.ErrorIs(t, err, errReceiptsNotStored)
+		blockchainMock.AssertNotCalled(t, "GetReceiptsByHash", mock.Anything)
+	})
+
+	t.Run("blocks with stored receipts", func(t *testing.T) {
+		t.Parallel()
+
+		blockchainMock := &blockchainMock{firstReceipts: 3}
+		blockchainMock.On("GetHeaderByNumber", mock.Anything).Return(func(number uint64) *types.Header {
+			return headers[number]
+		})
+		blockchainMock.On("GetReceiptsByHash", mock.Anything).Return([]*types.Receipt{{}}, nil)
+
+		var handled []uint64
+
+		getter := &receiptsGetter{blockchain: blockchainMock}
+		require.NoError(t, getter.getReceiptsFromBlocksRange(3, 4, func(h *types.Header, _ []*types.Receipt) error {
+			handled = append(handled, h.Number)
+
+			return nil
+		}))
+
+		require.Equal(t, []uint64{3, 4}, handled)
+	})
+}
@@ -7,13 +7,20 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/fourbytetracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
-const callTracerName = "callTracer"
+const (
+	callTracerName     = "callTracer"
+	prestateTracerName = "prestateTracer"
+	fourByteTracerName = "4byteTracer"
+)
 
 var (
 	defaultTraceTimeout = 5 * time.Second
@@ -80,13 +87,20 @@ func NewDebug(store debugStore, requestsPerSecond uint64) *Debug {
 }
 
 type TraceConfig struct {
-	EnableMemory      bool    `json:"enableMemory"`
-	DisableStack      bool    `json:"disableStack"`
-	DisableStorage    bool    `json:"disableStorage"`
-	EnableReturnData  bool    `json:"enableReturnData"`
-	DisableStructLogs bool    `json:"disableStructLogs"`
-	Timeout           *string `json:"timeout"`
-	Tracer            string  `json:"tracer"`
+	EnableMemory      bool          `json:"enableMemory"`
+	DisableStack      bool          `json:"disableStack"`
+	DisableStorage    bool          `json:"disableStorage"`
+	EnableReturnData  bool          `json:"enableReturnData"`
+	DisableStructLogs bool          `json:"disableStructLogs"`
+	Timeout           *string       `json:"timeout"`
+	Tracer            string        `json:"tracer"`
+	TracerConfig      *TracerConfig `json:"tracerConfig"`
+}
+
+// TracerConfig is the configuration of the native tracers
+type TracerConfig struct {
+	// DiffMode makes the prestateTracer return the pre and post state of the modified accounts
+	DiffMode bool `json:"diffMode"`
 }
 
 func (d *Debug) TraceBlockByNumber(
@@ -254,9 +268,14 @@ func newTracer(config *TraceConfig) (
 
 	var tracer tracer.Tracer
 
-	if config.Tracer == callTracerName {
+	switch config.Tracer {
+	case callTracerName:
 		tracer = &calltracer.CallTracer{}
-	} else {
+	case prestateTracerName:
+		tracer = prestatetracer.NewPrestateTracer(config.TracerConfig != nil && config.TracerConfig.DiffMode)
+	case fourByteTracerName:
+		tracer = fourbytetracer.NewFourByteTracer(precompiled.NewPrecompiled().Addrs...)
+	default:
 		tracer = structtracer.NewStructTracer(structtracer.Config{
 			EnableMemory:     config.EnableMemory && !config.DisableStructLogs,
 			EnableStack:      !config.DisableStack && !config.DisableStructLogs,
@@ -266,6 +285,13 @@ func newTracer(config *TraceConfig) (
 		})
 	}
 
+	// cancellation of context is done by caller
+	return tracer, cancelOnTimeout(tracer, timeout), nil
+}
+
+// cancelOnTimeout cancels the tracer with ErrExecutionTimeout once the timeout expires.
+// The returned function stops the timer and must be called by the caller
+func cancelOnTimeout(tracer tracer.Tracer, timeout time.Duration) context.CancelFunc {
 	timeoutCtx, cancel := context.WithTimeout(context.Background(), timeout)
 
 	go func() {
@@ -276,6 +302,5 @@ func newTracer(config *TraceConfig) (
 		}
 	}()
 
-	// cancellation of context is done by caller
-	return tracer, cancel, nil
+	return cancel
 }
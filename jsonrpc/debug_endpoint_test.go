@@ -11,6 +11,8 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/fourbytetracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
 	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/structtracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
@@ -833,4 +835,32 @@ func Test_newTracer(t *testing.T) {
 			EnableStructLogs: false,
 		}, st.Config)
 	})
+	t.Run("should create native tracers by name", func(t *testing.T) {
+		t.Parallel()
+
+		tracer, cancel, err := newTracer(&TraceConfig{
+			Tracer:       prestateTracerName,
+			TracerConfig: &TracerConfig{DiffMode: true},
+		})
+		require.NoError(t, err)
+
+		cancel()
+
+		_, ok := tracer.(*prestatetracer.PrestateTracer)
+		assert.True(t, ok)
+
+		res, err := tracer.GetResult()
+		assert.NoError(t, err)
+		assert.IsType(t, &prestatetracer.DiffResult{}, res)
+
+		tracer, cancel, err = newTracer(&TraceConfig{
+			Tracer: fourByteTracerName,
+		})
+		require.NoError(t, err)
+
+		cancel()
+
+		_, ok = tracer.(*fourbytetracer.FourByteTracer)
+		assert.True(t, ok)
+	})
 }
@@ -38,12 +38,14 @@ func (f *funcData) numParams() int {
 }
 
 type endpoints struct {
-	Eth    *Eth
-	Web3   *Web3
-	Net    *Net
-	TxPool *TxPool
-	Bridge *Bridge
-	Debug  *Debug
+	Eth         *Eth
+	Web3        *Web3
+	Net         *Net
+	TxPool      *TxPool
+	TxPoolAdmin *TxPoolAdmin
+	Bridge      *Bridge
+	Debug       *Debug
+	Trace       *Trace
 }
 
 // Dispatcher handles all json rpc requests by delegating
@@ -66,6 +68,8 @@ type dispatcherParams struct {
 	blockRangeLimit         uint64
 
 	concurrentRequestsDebug uint64
+
+	txPoolAdmin bool
 }
 
 func (dp dispatcherParams) isExceedingBatchLengthLimit(value uint64) bool {
@@ -116,6 +120,7 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		store,
 	}
 	d.endpoints.Debug = NewDebug(store, d.params.concurrentRequestsDebug)
+	d.endpoints.Trace = NewTrace(store, d.params.concurrentRequestsDebug, d.params.blockRangeLimit)
 
 	var err error
 
@@ -135,11 +140,23 @@ func (d *Dispatcher) registerEndpoints(store JSONRPCStore) error {
 		return err
 	}
 
+	if d.params.txPoolAdmin {
+		d.endpoints.TxPoolAdmin = &TxPoolAdmin{store}
+
+		if err = d.registerService("txpooladmin", d.endpoints.TxPoolAdmin); err != nil {
+			return err
+		}
+	}
+
 	if err = d.registerService("bridge", d.endpoints.Bridge); err != nil {
 		return err
 	}
 
-	return d.registerService("debug", d.endpoints.Debug)
+	if err = d.registerService("debug", d.endpoints.Debug); err != nil {
+		return err
+	}
+
+	return d.registerService("trace", d.endpoints.Trace)
 }
 
 func (d *Dispatcher) getFnHandler(req Request) (*serviceData, *funcData, Error) {
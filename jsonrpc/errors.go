@@ -10,6 +10,8 @@ import (
 
 var (
 	ErrStateNotFound = errors.New("given root and slot not found in storage")
+	// ErrReceiptsNotAvailable is returned for the blocks written by the state sync without being executed
+	ErrReceiptsNotAvailable = errors.New("receipts are not available for the blocks preceding the state sync pivot")
 )
 
 type Error interface {
@@ -313,6 +313,122 @@ func TestEth_GetTransactionReceipt(t *testing.T) {
 		assert.Equal(t, uint64(3), uint64(response.Logs[0].LogIndex))
 		assert.Equal(t, uint64(1), uint64(response.Logs[0].TxIndex))
 	})
+
+	t.Run("returns error for block preceding the state sync pivot", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.firstReceipts = 2
+		eth := newTestEthEndpoint(store)
+		block := newTestBlock(1, hash4)
+		store.add(block)
+		txn := newTestTransaction(uint64(0), addr0)
+		block.Transactions = []*types.Transaction{txn}
+		store.receipts[hash4] = []*types.Receipt{}
+
+		res, err := eth.GetTransactionReceipt(txn.Hash())
+
+		assert.ErrorIs(t, err, ErrReceiptsNotAvailable)
+		assert.Nil(t, res)
+	})
+}
+
+func TestEth_GetBlockReceipts(t *testing.T) {
+	t.Parallel()
+
+	t.Run("returns error if block not found", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		eth := newTestEthEndpoint(store)
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash1})
+
+		assert.Error(t, err)
+		assert.Nil(t, res)
+	})
+
+	t.Run("returns empty list for block without transactions", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		eth := newTestEthEndpoint(store)
+		block := newTestBlock(1, hash4)
+		store.add(block)
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash4})
+
+		assert.NoError(t, err)
+		assert.Equal(t, []*receipt{}, res)
+	})
+
+	t.Run("returns all receipts of the block", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		eth := newTestEthEndpoint(store)
+		block := newTestBlock(1, hash4)
+		store.add(block)
+		txn0 := newTestTransaction(uint64(0), addr0)
+		txn1 := newTestTransaction(uint64(1), addr1)
+		block.Transactions = []*types.Transaction{txn0, txn1}
+		contractAddr := types.StringToAddress("0x3")
+		receipt1 := &types.Receipt{
+			Logs: []*types.Log{
+				{Topics: []types.Hash{hash1}},
+				{Topics: []types.Hash{hash2}},
+			},
+			GasUsed:         100,
+			ContractAddress: &contractAddr,
+		}
+		receipt1.SetStatus(types.ReceiptSuccess)
+		receipt2 := &types.Receipt{
+			Logs: []*types.Log{
+				{Topics: []types.Hash{hash3}},
+			},
+			GasUsed: 200,
+		}
+		receipt2.SetStatus(types.ReceiptFailed)
+		store.receipts[hash4] = []*types.Receipt{receipt1, receipt2}
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash4})
+
+		assert.NoError(t, err)
+
+		response, ok := res.([]*receipt)
+		assert.True(t, ok)
+		assert.Len(t, response, 2)
+
+		assert.Equal(t, txn0.Hash(), response[0].TxHash)
+		assert.Equal(t, &contractAddr, response[0].ContractAddress)
+		assert.Equal(t, argBig(*big.NewInt(1)), response[0].EffectiveGasPrice)
+		assert.Len(t, response[0].Logs, 2)
+		assert.Equal(t, uint64(1), uint64(response[0].Logs[1].LogIndex))
+
+		assert.Equal(t, txn1.Hash(), response[1].TxHash)
+		assert.Equal(t, argUint64(1), response[1].TxIndex)
+		assert.Equal(t, argUint64(types.ReceiptFailed), response[1].Status)
+		assert.Len(t, response[1].Logs, 1)
+		assert.Equal(t, uint64(2), uint64(response[1].Logs[0].LogIndex))
+		assert.Equal(t, uint64(1), uint64(response[1].Logs[0].TxIndex))
+	})
+
+	t.Run("returns error for block preceding the state sync pivot", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockBlockStore()
+		store.firstReceipts = 2
+		eth := newTestEthEndpoint(store)
+		block := newTestBlock(1, hash4)
+		store.add(block)
+		block.Transactions = []*types.Transaction{newTestTransaction(uint64(0), addr0)}
+		store.receipts[hash4] = []*types.Receipt{}
+
+		res, err := eth.GetBlockReceipts(BlockNumberOrHash{BlockHash: &hash4})
+
+		assert.ErrorIs(t, err, ErrReceiptsNotAvailable)
+		assert.Nil(t, res)
+	})
 }
 
 func TestEth_Syncing(t *testing.T) {
@@ -524,6 +640,7 @@ type mockBlockStore struct {
 	returnValue     []byte
 	forksInTime     chain.ForksInTime
 	baseFee         uint64
+	firstReceipts   uint64
 
 	maxPriorityFeePerGasFn func() (*big.Int, error)
 }
@@ -633,6 +750,10 @@ func (m *mockBlockStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, e
 	return receipts, nil
 }
 
+func (m *mockBlockStore) FirstReceiptsNumber() uint64 {
+	return m.firstReceipts
+}
+
 func (m *mockBlockStore) GetBlockByNumber(blockNumber uint64, full bool) (*types.Block, bool) {
 	for _, b := range m.blocks {
 		if b.Number() == blockNumber {
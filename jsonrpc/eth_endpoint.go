@@ -14,6 +14,9 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/precompiled"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/accesslisttracer"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -29,6 +32,12 @@ type ethTxPoolStore interface {
 
 	// GetBaseFee returns the current base fee of TxPool
 	GetBaseFee() uint64
+
+	// AddBundle queues the transactions bundle for its target block
+	AddBundle(bundle *types.Bundle) (types.Hash, error)
+
+	// AddConditionalTx adds a new transaction to the tx pool, which can only be included while the conditions hold
+	AddConditionalTx(tx *types.Transaction, conditions *types.TxConditions) error
 }
 
 type Account struct {
@@ -36,11 +45,28 @@ type Account struct {
 	Nonce   uint64
 }
 
+// StorageProof is the merkle proof of a single storage slot
+type StorageProof struct {
+	Key   types.Hash
+	Value []byte
+	Proof [][]byte
+}
+
+// AccountProof is the merkle proof of an account and the requested storage slots
+type AccountProof struct {
+	Account
+	CodeHash     types.Hash
+	StorageRoot  types.Hash
+	Proof        [][]byte
+	StorageProof []StorageProof
+}
+
 type ethStateStore interface {
 	GetAccount(root types.Hash, addr types.Address) (*Account, error)
 	GetStorage(root types.Hash, addr types.Address, slot types.Hash) ([]byte, error)
 	GetForksInTime(blockNumber uint64) chain.ForksInTime
 	GetCode(root types.Hash, addr types.Address) ([]byte, error)
+	GetProof(root types.Hash, addr types.Address, storageKeys []types.Hash) (*AccountProof, error)
 }
 
 type ethBlockchainStore interface {
@@ -62,6 +88,9 @@ type ethBlockchainStore interface {
 	// GetReceiptsByHash returns the receipts for a block hash
 	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
 
+	// FirstReceiptsNumber returns the number of the first block whose receipts are stored
+	FirstReceiptsNumber() uint64
+
 	// GetAvgGasPrice returns the average gas price
 	GetAvgGasPrice() *big.Int
 
@@ -73,6 +102,15 @@ type ethBlockchainStore interface {
 		nonPayable bool,
 	) (*runtime.ExecutionResult, error)
 
+	// ApplyTxnWithTracer applies a transaction object to the blockchain and traces it with the given tracer
+	ApplyTxnWithTracer(
+		header *types.Header,
+		txn *types.Transaction,
+		override types.StateOverride,
+		nonPayable bool,
+		tracer tracer.Tracer,
+	) (*runtime.ExecutionResult, error)
+
 	// GetSyncProgression retrieves the current sync progression, if any
 	GetSyncProgression() *progress.Progression
 }
@@ -247,6 +285,81 @@ func (e *Eth) SendRawTransaction(buf argBytes) (interface{}, error) {
 	return tx.Hash().String(), nil
 }
 
+// SendRawTransactionConditional sends a transaction which can only be included in a block
+// while the expected storage slots values and the block range conditions hold
+func (e *Eth) SendRawTransactionConditional(buf argBytes, args txConditionsArgs) (interface{}, error) {
+	tx := &types.Transaction{}
+	if err := tx.UnmarshalRLP(buf); err != nil {
+		return nil, err
+	}
+
+	conditions := &types.TxConditions{
+		KnownAccounts: make(map[types.Address]map[types.Hash]types.Hash, len(args.KnownAccounts)),
+	}
+
+	for addr, slots := range args.KnownAccounts {
+		conditions.KnownAccounts[addr] = slots
+	}
+
+	if args.BlockNumberMin != nil {
+		conditions.BlockNumberMin = uint64(*args.BlockNumberMin)
+	}
+
+	if args.BlockNumberMax != nil {
+		conditions.BlockNumberMax = uint64(*args.BlockNumberMax)
+	}
+
+	if args.TimestampMin != nil {
+		conditions.TimestampMin = uint64(*args.TimestampMin)
+	}
+
+	if args.TimestampMax != nil {
+		conditions.TimestampMax = uint64(*args.TimestampMax)
+	}
+
+	// tx hash will be calculated inside e.store.AddConditionalTx
+	if err := e.store.AddConditionalTx(tx, conditions); err != nil {
+		return nil, err
+	}
+
+	return tx.Hash().String(), nil
+}
+
+// SendBundle sends the signed transactions which have to be included in the target block atomically,
+// i.e. in the given order and only if every one of them succeeds. The bundles are not gossiped,
+// so they have to be sent to every validator, since only the proposer of the target block includes them
+func (e *Eth) SendBundle(args *bundleArgs) (interface{}, error) {
+	bundle := &types.Bundle{
+		Txs:         make([]*types.Transaction, len(args.Txs)),
+		BlockNumber: uint64(args.BlockNumber),
+	}
+
+	if args.MinTimestamp != nil {
+		bundle.MinTimestamp = uint64(*args.MinTimestamp)
+	}
+
+	if args.MaxTimestamp != nil {
+		bundle.MaxTimestamp = uint64(*args.MaxTimestamp)
+	}
+
+	for i, raw := range args.Txs {
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, fmt.Errorf("invalid bundle transaction at index %d: %w", i, err)
+		}
+
+		bundle.Txs[i] = tx
+	}
+
+	// bundle hash will be calculated inside e.store.AddBundle
+	hash, err := e.store.AddBundle(bundle)
+	if err != nil {
+		return nil, err
+	}
+
+	return &sendBundleResult{BundleHash: hash}, nil
+}
+
 // SendTransaction rejects eth_sendTransaction json-rpc call as we don't support wallet management
 func (e *Eth) SendTransaction(_ *txnArgs) (interface{}, error) {
 	return nil, fmt.Errorf("request calls to eth_sendTransaction method are not supported," +
@@ -336,6 +449,10 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 		return nil, nil
 	}
 
+	if err := checkReceiptsAvailable(e.store, block.Number()); err != nil {
+		return nil, err
+	}
+
 	receipts, err := e.store.GetReceiptsByHash(blockHash)
 	if err != nil {
 		// block receipts not found
@@ -375,6 +492,59 @@ func (e *Eth) GetTransactionReceipt(hash types.Hash) (interface{}, error) {
 	return toReceipt(raw, txn, uint64(txIndex), block.Header, logs), nil
 }
 
+// GetBlockReceipts returns all the transaction receipts of the given block
+func (e *Eth) GetBlockReceipts(filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	block, ok := e.store.GetBlockByHash(header.Hash, true)
+	if !ok {
+		// block not found
+		return nil, nil
+	}
+
+	if len(block.Transactions) == 0 {
+		return []*receipt{}, nil
+	}
+
+	if err := checkReceiptsAvailable(e.store, block.Number()); err != nil {
+		return nil, err
+	}
+
+	receipts, err := e.store.GetReceiptsByHash(block.Hash())
+	if err != nil {
+		// block receipts not found
+		e.logger.Warn(
+			fmt.Sprintf("Receipts for block with hash [%s] not found", block.Hash().String()),
+		)
+
+		return nil, nil
+	}
+
+	if len(receipts) != len(block.Transactions) {
+		// Receipts not written yet on the db
+		e.logger.Warn(
+			fmt.Sprintf("Incomplete receipts found for block with hash [%s]", block.Hash().String()),
+		)
+
+		return nil, nil
+	}
+
+	result := make([]*receipt, len(receipts))
+	logIndex := 0
+
+	for txIndex, raw := range receipts {
+		txn := block.Transactions[txIndex]
+		logs := toLogs(raw.Logs, uint64(logIndex), uint64(txIndex), block.Header, txn.Hash())
+		result[txIndex] = toReceipt(raw, txn, uint64(txIndex), block.Header, logs)
+		logIndex += len(raw.Logs)
+	}
+
+	return result, nil
+}
+
 // GetStorageAt returns the contract storage at the index position
 func (e *Eth) GetStorageAt(
 	address types.Address,
@@ -399,6 +569,26 @@ func (e *Eth) GetStorageAt(
 	return argBytesPtr(result), nil
 }
 
+// GetProof returns the account and storage values of the given account
+// including the merkle proofs (EIP-1186)
+func (e *Eth) GetProof(
+	address types.Address,
+	storageKeys []types.Hash,
+	filter BlockNumberOrHash,
+) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	proof, err := e.store.GetProof(header.StateRoot, address, storageKeys)
+	if err != nil {
+		return nil, err
+	}
+
+	return toAccountProof(address, proof), nil
+}
+
 // GasPrice exposes "getGasPrice"'s function logic to public RPC interface
 func (e *Eth) GasPrice() (interface{}, error) {
 	gasPrice, err := e.getGasPrice()
@@ -533,6 +723,90 @@ func (e *Eth) Call(arg *txnArgs, filter BlockNumberOrHash, apiOverride *stateOve
 	return argBytesPtr(result.ReturnValue), nil
 }
 
+// CreateAccessList creates an EIP-2930 access list for the given transaction, by simulating
+// its execution on top of the given block. The simulation is repeated with the collected access list
+// attached to the transaction until the list doesn't change anymore, because accessing warm slots
+// can change the execution path (for example when the gas left is checked by the contract)
+func (e *Eth) CreateAccessList(arg *txnArgs, filter BlockNumberOrHash) (interface{}, error) {
+	header, err := GetHeaderFromBlockNumberOrHash(filter, e.store)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := DecodeTxn(arg, header.Number, e.store, true)
+	if err != nil {
+		return nil, err
+	}
+
+	// If the caller didn't supply the gas limit in the message, then we set it to maximum possible => block gas limit
+	if transaction.Gas() == 0 {
+		transaction.SetGas(header.GasLimit)
+	}
+
+	// Force transaction gas price if empty
+	if err = e.fillTransactionGasPrice(transaction); err != nil {
+		return nil, err
+	}
+
+	// legacy transactions can not carry an access list
+	if transaction.Type() == types.LegacyTxType {
+		accessListTx := types.NewTxWithType(types.AccessListTxType)
+		accessListTx.SetGasPrice(transaction.GasPrice())
+		accessListTx.SetFrom(transaction.From())
+		accessListTx.SetGas(transaction.Gas())
+		accessListTx.SetValue(transaction.Value())
+		accessListTx.SetInput(transaction.Input())
+		accessListTx.SetNonce(transaction.Nonce())
+		accessListTx.SetTo(transaction.To())
+		accessListTx.SetChainID(new(big.Int).SetUint64(e.chainID))
+
+		transaction = accessListTx
+	}
+
+	providedAccessList := transaction.AccessList()
+
+	// gas used without any access list attached
+	transaction.SetAccessList(nil)
+
+	result, err := e.store.ApplyTxn(header, transaction.Copy(), nil, true)
+	if err != nil {
+		return nil, err
+	}
+
+	gasUsedWithoutAccessList := result.GasUsed
+	forksInTime := e.store.GetForksInTime(header.Number)
+	precompiles := precompiled.NewPrecompiled().ActiveAddrs(&forksInTime)
+	prevTracer := accesslisttracer.NewAccessListTracer(providedAccessList, precompiles...)
+
+	for {
+		accessList := prevTracer.AccessList()
+		transaction.SetAccessList(accessList)
+
+		accessListTracer := accesslisttracer.NewAccessListTracer(accessList, precompiles...)
+
+		result, err := e.store.ApplyTxnWithTracer(header, transaction.Copy(), nil, true, accessListTracer)
+		if err != nil {
+			return nil, fmt.Errorf("failed to apply transaction with access list: %w", err)
+		}
+
+		if accessListTracer.Equal(prevTracer) {
+			res := &accessListResult{
+				AccessList:               accessList,
+				GasUsed:                  argUint64(result.GasUsed),
+				GasUsedWithoutAccessList: argUint64(gasUsedWithoutAccessList),
+			}
+
+			if result.Failed() {
+				res.Error = result.Err.Error()
+			}
+
+			return res, nil
+		}
+
+		prevTracer = accessListTracer
+	}
+}
+
 // EstimateGas estimates the gas needed to execute a transaction
 func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error) {
 	number := LatestBlockNumber
@@ -557,7 +831,8 @@ func (e *Eth) EstimateGas(arg *txnArgs, rawNum *BlockNumber) (interface{}, error
 	if transaction.IsValueTransfer() {
 		// if it is a simple value transfer or a contract creation,
 		// we already know what is the transaction gas cost, no need to apply transaction
-		gasCost, err := state.TransactionGasCost(transaction, forksInTime.Homestead, forksInTime.Istanbul)
+		gasCost, err := state.TransactionGasCost(transaction, forksInTime.Homestead, forksInTime.Istanbul,
+			forksInTime.Shanghai)
 		if err != nil {
 			return nil, err
 		}
@@ -9,6 +9,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/helper/hex"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
 )
@@ -612,6 +614,111 @@ func getExampleStore() *mockSpecialStore {
 // TestEth_EstimateGas_GasLimit tests eth_estimateGas, by using
 // the latest block gas limit for the upper bound, or the specified
 // gas limit in the transaction
+func TestEth_State_GetProof(t *testing.T) {
+	store := &mockSpecialStore{
+		account: &mockAccount{
+			address: addr0,
+			account: &Account{
+				Balance: big.NewInt(100),
+				Nonce:   3,
+			},
+			storage: map[types.Hash][]byte{
+				hash1: {0x1, 0x2},
+			},
+		},
+		block: &types.Block{
+			Header: &types.Header{
+				Hash:      types.ZeroHash,
+				Number:    0,
+				StateRoot: types.EmptyRootHash,
+			},
+		},
+	}
+
+	eth := newTestEthEndpoint(store)
+	blockNumberLatest := LatestBlockNumber
+	blockNumberInvalid := BlockNumber(0x1)
+
+	t.Run("should return account and storage proofs", func(t *testing.T) {
+		res, err := eth.GetProof(addr0, []types.Hash{hash1}, BlockNumberOrHash{BlockNumber: &blockNumberLatest})
+		assert.NoError(t, err)
+
+		proof, ok := res.(*accountProof)
+		assert.True(t, ok)
+
+		assert.Equal(t, addr0, proof.Address)
+		assert.Equal(t, argBig(*big.NewInt(100)), proof.Balance)
+		assert.Equal(t, argUint64(3), proof.Nonce)
+		assert.Equal(t, types.EmptyCodeHash, proof.CodeHash)
+		assert.Len(t, proof.AccountProof, 1)
+		assert.Len(t, proof.StorageProof, 1)
+		assert.Equal(t, hash1, proof.StorageProof[0].Key)
+		assert.Equal(t, argBig(*big.NewInt(0x0102)), proof.StorageProof[0].Value)
+	})
+
+	t.Run("should fail for invalid block number", func(t *testing.T) {
+		_, err := eth.GetProof(addr0, nil, BlockNumberOrHash{BlockNumber: &blockNumberInvalid})
+		assert.Error(t, err)
+	})
+}
+
+func TestEth_CreateAccessList(t *testing.T) {
+	slot := types.StringToHash("0x5")
+	store := &mockSpecialStore{
+		account: &mockAccount{
+			address: addr0,
+			account: &Account{
+				Balance: big.NewInt(100),
+			},
+			storage: make(map[types.Hash][]byte),
+		},
+		block: &types.Block{
+			Header: &types.Header{
+				Hash:      types.ZeroHash,
+				Number:    0,
+				StateRoot: types.EmptyRootHash,
+				GasLimit:  100000,
+			},
+		},
+		applyTxnTracerHook: func(txn *types.Transaction, tr tracer.Tracer) *runtime.ExecutionResult {
+			if tr != nil {
+				tr.CallStart(1, txn.From(), *txn.To(), 0, txn.Gas(), txn.Value(), txn.Input())
+				tr.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(slot.Bytes())}, evm.SLOAD, *txn.To(), 1, nil, nil)
+				tr.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(uninitializedAddress.Bytes())},
+					evm.EXTCODESIZE, *txn.To(), 1, nil, nil)
+			}
+
+			gasUsed := uint64(30000)
+			if len(txn.AccessList()) > 0 {
+				gasUsed = 29000
+			}
+
+			return &runtime.ExecutionResult{GasUsed: gasUsed}
+		},
+	}
+
+	eth := newTestEthEndpoint(store)
+	to := types.StringToAddress("0x7")
+	blockNumberLatest := LatestBlockNumber
+
+	res, err := eth.CreateAccessList(
+		&txnArgs{From: &addr0, To: &to, Data: argBytesPtr([]byte{0x1}), GasPrice: argBytesPtr([]byte{0x1})},
+		BlockNumberOrHash{BlockNumber: &blockNumberLatest},
+	)
+	assert.NoError(t, err)
+
+	result, ok := res.(*accessListResult)
+	assert.True(t, ok)
+
+	assert.Equal(t, types.TxAccessList{
+		{Address: to, StorageKeys: []types.Hash{slot}},
+		{Address: uninitializedAddress, StorageKeys: []types.Hash{}},
+	}, result.AccessList)
+	assert.Equal(t, argUint64(29000), result.GasUsed)
+	assert.Equal(t, argUint64(30000), result.GasUsedWithoutAccessList)
+	assert.Empty(t, result.Error)
+}
+
 func TestEth_EstimateGas_GasLimit(t *testing.T) {
 	t.Parallel()
 
@@ -832,7 +939,8 @@ type mockSpecialStore struct {
 	account *mockAccount
 	block   *types.Block
 
-	applyTxnHook func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	applyTxnHook       func(header *types.Header, txn *types.Transaction) (*runtime.ExecutionResult, error)
+	applyTxnTracerHook func(txn *types.Transaction, tracer tracer.Tracer) *runtime.ExecutionResult
 }
 
 func (m *mockSpecialStore) GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool) {
@@ -898,14 +1006,64 @@ func (m *mockSpecialStore) GetCode(root types.Hash, addr types.Address) ([]byte,
 	return m.account.code, nil
 }
 
+func (m *mockSpecialStore) GetProof(
+	root types.Hash,
+	addr types.Address,
+	storageKeys []types.Hash,
+) (*AccountProof, error) {
+	res := &AccountProof{
+		Account:      Account{Balance: big.NewInt(0)},
+		CodeHash:     types.EmptyCodeHash,
+		StorageRoot:  types.EmptyRootHash,
+		Proof:        [][]byte{{0x1}},
+		StorageProof: make([]StorageProof, len(storageKeys)),
+	}
+
+	if m.account.address == addr {
+		res.Account = *m.account.account
+	}
+
+	for i, key := range storageKeys {
+		res.StorageProof[i] = StorageProof{
+			Key:   key,
+			Value: m.account.storage[key],
+			Proof: [][]byte{{0x2}},
+		}
+	}
+
+	return res, nil
+}
+
+func (m *mockSpecialStore) GetBaseFee() uint64 {
+	return 0
+}
+
 func (m *mockSpecialStore) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return chain.AllForksEnabled.At(0)
 }
 
 func (m *mockSpecialStore) ApplyTxn(header *types.Header, txn *types.Transaction, _ types.StateOverride, _ bool) (*runtime.ExecutionResult, error) {
+	if m.applyTxnTracerHook != nil {
+		return m.applyTxnTracerHook(txn, nil), nil
+	}
+
 	if m.applyTxnHook != nil {
 		return m.applyTxnHook(header, txn)
 	}
 
 	return &runtime.ExecutionResult{}, nil
 }
+
+func (m *mockSpecialStore) ApplyTxnWithTracer(
+	header *types.Header,
+	txn *types.Transaction,
+	override types.StateOverride,
+	nonPayable bool,
+	tracer tracer.Tracer,
+) (*runtime.ExecutionResult, error) {
+	if m.applyTxnTracerHook != nil {
+		return m.applyTxnTracerHook(txn, tracer), nil
+	}
+
+	return m.ApplyTxn(header, txn, override, nonPayable)
+}
@@ -1,11 +1,13 @@
 package jsonrpc
 
 import (
+	"encoding/json"
 	"math/big"
 	"testing"
 
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 func TestEth_TxnPool_SendRawTransaction(t *testing.T) {
@@ -44,10 +46,79 @@ func TestEth_TxnPool_SendTransaction(t *testing.T) {
 	assert.NotEqual(t, store.txn.Hash(), types.ZeroHash)
 }
 
+func TestEth_TxnPool_SendBundle(t *testing.T) {
+	store := &mockStoreTxn{}
+	eth := newTestEthEndpoint(store)
+
+	txs := make([]argBytes, 2)
+
+	for i := range txs {
+		txs[i] = types.NewTx(types.NewLegacyTx(
+			types.WithFrom(addr0),
+			types.WithNonce(uint64(i)),
+			types.WithSignatureValues(big.NewInt(1), nil, nil),
+		)).MarshalRLP()
+	}
+
+	maxTimestamp := argUint64(200)
+
+	res, err := eth.SendBundle(&bundleArgs{
+		Txs:          txs,
+		BlockNumber:  argUint64(10),
+		MaxTimestamp: &maxTimestamp,
+	})
+	assert.NoError(t, err)
+
+	assert.Len(t, store.bundle.Txs, 2)
+	assert.Equal(t, uint64(1), store.bundle.Txs[1].Nonce())
+	assert.Equal(t, uint64(10), store.bundle.BlockNumber)
+	assert.Equal(t, uint64(0), store.bundle.MinTimestamp)
+	assert.Equal(t, uint64(200), store.bundle.MaxTimestamp)
+	assert.Equal(t, &sendBundleResult{BundleHash: store.bundle.Hash()}, res)
+
+	_, err = eth.SendBundle(&bundleArgs{Txs: []argBytes{{0x1}}})
+	assert.Error(t, err)
+}
+
+func TestEth_TxnPool_SendRawTransactionConditional(t *testing.T) {
+	store := &mockStoreTxn{}
+	eth := newTestEthEndpoint(store)
+	txn := types.NewTx(types.NewLegacyTx(
+		types.WithFrom(addr0),
+		types.WithSignatureValues(big.NewInt(1), nil, nil),
+	))
+
+	var args txConditionsArgs
+
+	require.NoError(t, json.Unmarshal([]byte(`{
+		"knownAccounts": {"0x0000000000000000000000000000000000000001": {"0x01": "0x02"}},
+		"blockNumberMax": "0x10",
+		"timestampMin": "0x20"
+	}`), &args))
+
+	res, err := eth.SendRawTransactionConditional(txn.MarshalRLP(), args)
+	assert.NoError(t, err)
+	assert.Equal(t, store.txn.Hash().String(), res)
+	assert.Equal(t, &types.TxConditions{
+		KnownAccounts: map[types.Address]map[types.Hash]types.Hash{
+			types.StringToAddress("0x1"): {types.StringToHash("0x1"): types.StringToHash("0x2")},
+		},
+		BlockNumberMax: 16,
+		TimestampMin:   32,
+	}, store.conditions)
+
+	// the expected storage roots are not supported
+	assert.ErrorContains(t, json.Unmarshal([]byte(`{
+		"knownAccounts": {"0x0000000000000000000000000000000000000001": "0x01"}
+	}`), &args), "storage root conditions are not supported")
+}
+
 type mockStoreTxn struct {
 	ethStore
-	accounts map[types.Address]*mockAccount
-	txn      *types.Transaction
+	accounts   map[types.Address]*mockAccount
+	txn        *types.Transaction
+	bundle     *types.Bundle
+	conditions *types.TxConditions
 }
 
 func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
@@ -58,6 +129,22 @@ func (m *mockStoreTxn) AddTx(tx *types.Transaction) error {
 	return nil
 }
 
+func (m *mockStoreTxn) AddConditionalTx(tx *types.Transaction, conditions *types.TxConditions) error {
+	m.conditions = conditions
+
+	return m.AddTx(tx)
+}
+
+func (m *mockStoreTxn) AddBundle(bundle *types.Bundle) (types.Hash, error) {
+	m.bundle = bundle
+
+	for _, tx := range bundle.Txs {
+		tx.ComputeHash()
+	}
+
+	return bundle.Hash(), nil
+}
+
 func (m *mockStoreTxn) GetNonce(addr types.Address) uint64 {
 	return 1
 }
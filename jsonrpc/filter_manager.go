@@ -302,6 +302,9 @@ type filterManagerStore interface {
 	// GetReceiptsByHash returns the receipts for a block hash
 	GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
 
+	// FirstReceiptsNumber returns the number of the first block whose receipts are stored
+	FirstReceiptsNumber() uint64
+
 	// GetBlockByHash returns the block using the block hash
 	GetBlockByHash(hash types.Hash, full bool) (*types.Block, bool)
 
@@ -484,6 +487,10 @@ func (f *FilterManager) Exists(id string) bool {
 }
 
 func (f *FilterManager) getLogsFromBlock(query *LogQuery, block *types.Block) ([]*Log, error) {
+	if err := checkReceiptsAvailable(f.store, block.Number()); err != nil {
+		return nil, err
+	}
+
 	receipts, err := f.store.GetReceiptsByHash(block.Header.Hash)
 	if err != nil {
 		return nil, err
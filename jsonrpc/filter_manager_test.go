@@ -148,6 +148,52 @@ func Test_GetLogsForQuery(t *testing.T) {
 	}
 }
 
+func Test_GetLogsForQuery_ReceiptsNotAvailable(t *testing.T) {
+	t.Parallel()
+
+	topic := types.StringToHash("4")
+
+	// the blocks preceding the third one are written by the state sync without their receipts
+	store := &mockBlockStore{
+		topics:        []types.Hash{topic},
+		firstReceipts: 3,
+	}
+	store.setupLogs()
+
+	blocks := make([]*types.Block, 5)
+
+	for i := range blocks {
+		blocks[i] = &types.Block{
+			Header: &types.Header{
+				Number: uint64(i),
+				Hash:   types.StringToHash(strconv.Itoa(i)),
+			},
+			Transactions: []*types.Transaction{
+				types.NewTx(types.NewLegacyTx(types.WithValue(big.NewInt(10)))),
+			},
+		}
+	}
+
+	store.appendBlocksToStore(blocks)
+
+	f := NewFilterManager(hclog.NewNullLogger(), store, 1000)
+
+	t.Cleanup(func() {
+		defer f.Close()
+	})
+
+	_, err := f.GetLogsForQuery(&LogQuery{fromBlock: 1, toBlock: 4, Topics: [][]types.Hash{{topic}}})
+	require.ErrorIs(t, err, ErrReceiptsNotAvailable)
+
+	blockHash := types.StringToHash("2")
+
+	_, err = f.GetLogsForQuery(&LogQuery{BlockHash: &blockHash, Topics: [][]types.Hash{{topic}}})
+	require.ErrorIs(t, err, ErrReceiptsNotAvailable)
+
+	_, err = f.GetLogsForQuery(&LogQuery{fromBlock: 3, toBlock: 4, Topics: [][]types.Hash{{topic}}})
+	require.NoError(t, err)
+}
+
 func Test_getLogsFromBlock(t *testing.T) {
 	t.Parallel()
 
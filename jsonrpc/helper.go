@@ -241,13 +241,17 @@ func DecodeTxn(arg *txnArgs, blockNumber uint64, store nonceGetter, forceSetNonc
 	txn := types.NewTxWithType(txType)
 
 	switch txType {
-	case types.LegacyTxType:
+	case types.LegacyTxType, types.AccessListTxType:
 		txn.SetGasPrice(new(big.Int).SetBytes(*arg.GasPrice))
 	case types.DynamicFeeTxType:
 		txn.SetGasTipCap(new(big.Int).SetBytes(*arg.GasTipCap))
 		txn.SetGasFeeCap(new(big.Int).SetBytes(*arg.GasFeeCap))
 	}
 
+	if arg.AccessList != nil {
+		txn.SetAccessList(*arg.AccessList)
+	}
+
 	txn.SetFrom(*arg.From)
 	txn.SetGas(uint64(*arg.Gas))
 	txn.SetValue(new(big.Int).SetBytes(*arg.Value))
@@ -262,3 +266,18 @@ func DecodeTxn(arg *txnArgs, blockNumber uint64, store nonceGetter, forceSetNonc
 
 	return txn, nil
 }
+
+type receiptsAvailability interface {
+	FirstReceiptsNumber() uint64
+}
+
+// checkReceiptsAvailable returns an error if the receipts of the block with the given number are not stored.
+// The blocks preceding the state sync pivot are written without being executed, so they have no receipts
+func checkReceiptsAvailable(store receiptsAvailability, number uint64) error {
+	if first := store.FirstReceiptsNumber(); number < first {
+		return fmt.Errorf("%w: receipts of block %d are missing, they are available from block %d",
+			ErrReceiptsNotAvailable, number, first)
+	}
+
+	return nil
+}
@@ -54,9 +54,11 @@ type JSONRPCStore interface {
 	ethStore
 	networkStore
 	txPoolStore
+	txPoolAdminStore
 	filterManagerStore
 	bridgeStore
 	debugStore
+	traceStore
 }
 
 type Config struct {
@@ -73,6 +75,8 @@ type Config struct {
 	WebSocketReadLimit      uint64
 	TLSCertFile             string
 	TLSKeyFile              string
+
+	TxPoolAdmin bool
 }
 
 // NewJSONRPC returns the JSONRPC http server
@@ -87,6 +91,7 @@ func NewJSONRPC(logger hclog.Logger, config *Config) (*JSONRPC, error) {
 			jsonRPCBatchLengthLimit: config.BatchLengthLimit,
 			blockRangeLimit:         config.BlockRangeLimit,
 			concurrentRequestsDebug: config.ConcurrentRequestsDebug,
+			txPoolAdmin:             config.TxPoolAdmin,
 		},
 	)
 
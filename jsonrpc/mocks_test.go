@@ -51,6 +51,7 @@ type mockStore struct {
 	receiptsLock  sync.Mutex
 	receipts      map[types.Hash][]*types.Receipt
 	accounts      map[types.Address]*Account
+	firstReceipts uint64
 
 	// headers is the list of historical headers
 	historicalHeaders []*types.Header
@@ -145,6 +146,10 @@ func (m *mockStore) GetReceiptsByHash(hash types.Hash) ([]*types.Receipt, error)
 	return receipts, nil
 }
 
+func (m *mockStore) FirstReceiptsNumber() uint64 {
+	return m.firstReceipts
+}
+
 func (m *mockStore) SubscribeEvents() blockchain.Subscription {
 	return m.subscription
 }
@@ -0,0 +1,571 @@
+package jsonrpc
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	traceTypeTrace     = "trace"
+	traceTypeStateDiff = "stateDiff"
+	traceTypeVMTrace   = "vmTrace"
+
+	flatTraceTypeCall   = "call"
+	flatTraceTypeCreate = "create"
+)
+
+var (
+	// ErrUnknownTraceType is returned when an unsupported trace type is requested
+	ErrUnknownTraceType = errors.New("unknown trace type")
+)
+
+type traceStore interface {
+	debugBlockchainStore
+}
+
+// Trace is the trace jsonrpc endpoint, which serves Parity/OpenEthereum-style flat traces
+type Trace struct {
+	store           traceStore
+	throttling      *Throttling
+	blockRangeLimit uint64
+}
+
+func NewTrace(store traceStore, requestsPerSecond uint64, blockRangeLimit uint64) *Trace {
+	return &Trace{
+		store:           store,
+		throttling:      NewThrottling(requestsPerSecond, time.Second),
+		blockRangeLimit: blockRangeLimit,
+	}
+}
+
+// TraceFilterRequest is the filter of the trace_filter call
+type TraceFilterRequest struct {
+	FromBlock   *BlockNumber    `json:"fromBlock"`
+	ToBlock     *BlockNumber    `json:"toBlock"`
+	FromAddress []types.Address `json:"fromAddress"`
+	ToAddress   []types.Address `json:"toAddress"`
+	After       *argUint64      `json:"after"`
+	Count       *argUint64      `json:"count"`
+}
+
+// Block returns the flat call traces of all the transactions in the block
+func (t *Trace) Block(blockNumber BlockNumber) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			num, err := GetNumericBlockNumber(blockNumber, t.store)
+			if err != nil {
+				return nil, err
+			}
+
+			block, ok := t.store.GetBlockByNumber(num, true)
+			if !ok {
+				return nil, fmt.Errorf("block %d not found", num)
+			}
+
+			return t.blockTraces(block)
+		},
+	)
+}
+
+// Transaction returns the flat call traces of the transaction
+func (t *Trace) Transaction(txHash types.Hash) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			tx, block := GetTxAndBlockByTxHash(txHash, t.store)
+			if tx == nil {
+				return nil, fmt.Errorf("tx %s not found", txHash.String())
+			}
+
+			if block.Number() == 0 {
+				return nil, ErrTraceGenesisBlock
+			}
+
+			callTracer := calltracer.NewCallTracer(calltracer.Config{ContinueOnError: true})
+
+			cancel := cancelOnTimeout(callTracer, defaultTraceTimeout)
+			defer cancel()
+
+			res, err := t.store.TraceTxn(block, tx.Hash(), callTracer)
+			if err != nil {
+				return nil, err
+			}
+
+			txIndex := 0
+
+			for idx, blockTx := range block.Transactions {
+				if blockTx.Hash() == tx.Hash() {
+					txIndex = idx
+
+					break
+				}
+			}
+
+			traces := flattenCallTrace(res, nil, make([]*flatTrace, 0))
+			setTraceLocation(traces, block, tx.Hash(), txIndex)
+
+			return traces, nil
+		},
+	)
+}
+
+// Filter returns the flat call traces of the given block range,
+// matching the sender and receiver addresses of the filter
+func (t *Trace) Filter(filter TraceFilterRequest) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			fromBlock, toBlock := LatestBlockNumber, LatestBlockNumber
+			if filter.FromBlock != nil {
+				fromBlock = *filter.FromBlock
+			}
+
+			if filter.ToBlock != nil {
+				toBlock = *filter.ToBlock
+			}
+
+			from, err := GetNumericBlockNumber(fromBlock, t.store)
+			if err != nil {
+				return nil, err
+			}
+
+			to, err := GetNumericBlockNumber(toBlock, t.store)
+			if err != nil {
+				return nil, err
+			}
+
+			if to < from {
+				return nil, ErrIncorrectBlockRange
+			}
+
+			// genesis block has no transactions
+			if from == 0 {
+				from = 1
+			}
+
+			// if not disabled, avoid handling large block ranges
+			if t.blockRangeLimit != 0 && to-from > t.blockRangeLimit {
+				return nil, ErrBlockRangeTooHigh
+			}
+
+			fromAddresses := toAddressSet(filter.FromAddress)
+			toAddresses := toAddressSet(filter.ToAddress)
+
+			var skip, count uint64
+			if filter.After != nil {
+				skip = uint64(*filter.After)
+			}
+
+			if filter.Count != nil {
+				count = uint64(*filter.Count)
+			}
+
+			result := make([]*flatTrace, 0)
+
+			for i := from; i <= to; i++ {
+				block, ok := t.store.GetBlockByNumber(i, true)
+				if !ok {
+					break
+				}
+
+				if len(block.Transactions) == 0 {
+					continue
+				}
+
+				traces, err := t.blockTraces(block)
+				if err != nil {
+					return nil, err
+				}
+
+				for _, trace := range traces {
+					if !trace.matches(fromAddresses, toAddresses) {
+						continue
+					}
+
+					if skip > 0 {
+						skip--
+
+						continue
+					}
+
+					result = append(result, trace)
+
+					if count != 0 && uint64(len(result)) == count {
+						return result, nil
+					}
+				}
+			}
+
+			return result, nil
+		},
+	)
+}
+
+// ReplayBlockTransactions replays all the transactions in the block
+// and returns the requested trace types for each of them
+func (t *Trace) ReplayBlockTransactions(blockNumber BlockNumber, traceTypes []string) (interface{}, error) {
+	return t.throttling.AttemptRequest(
+		context.Background(),
+		func() (interface{}, error) {
+			var withTrace, withStateDiff bool
+
+			for _, traceType := range traceTypes {
+				switch traceType {
+				case traceTypeTrace:
+					withTrace = true
+				case traceTypeStateDiff:
+					withStateDiff = true
+				case traceTypeVMTrace:
+					// vm traces are not supported and always returned as null
+				default:
+					return nil, fmt.Errorf("%w: %s", ErrUnknownTraceType, traceType)
+				}
+			}
+
+			num, err := GetNumericBlockNumber(blockNumber, t.store)
+			if err != nil {
+				return nil, err
+			}
+
+			block, ok := t.store.GetBlockByNumber(num, true)
+			if !ok {
+				return nil, fmt.Errorf("block %d not found", num)
+			}
+
+			if block.Number() == 0 {
+				return nil, ErrTraceGenesisBlock
+			}
+
+			callTraces, err := t.traceBlock(block, calltracer.NewCallTracer(calltracer.Config{ContinueOnError: true}))
+			if err != nil {
+				return nil, err
+			}
+
+			var diffs []interface{}
+
+			if withStateDiff {
+				if diffs, err = t.traceBlock(block, prestatetracer.NewPrestateTracer(true)); err != nil {
+					return nil, err
+				}
+			}
+
+			results := make([]*traceReplayResult, len(block.Transactions))
+
+			for idx, tx := range block.Transactions {
+				res := &traceReplayResult{
+					Output:          "0x",
+					TransactionHash: tx.Hash(),
+				}
+
+				if call, ok := callTraces[idx].(*calltracer.Call); ok && call != nil {
+					res.Output = call.Output
+				}
+
+				if withTrace {
+					res.Trace = flattenCallTrace(callTraces[idx], nil, make([]*flatTrace, 0))
+				}
+
+				if withStateDiff {
+					if diff, ok := diffs[idx].(*prestatetracer.DiffResult); ok {
+						res.StateDiff = toStateDiff(diff)
+					}
+				}
+
+				results[idx] = res
+			}
+
+			return results, nil
+		},
+	)
+}
+
+// blockTraces returns the flat call traces of all the transactions in the block
+func (t *Trace) blockTraces(block *types.Block) ([]*flatTrace, error) {
+	traces := make([]*flatTrace, 0)
+
+	if block.Number() == 0 {
+		return traces, nil
+	}
+
+	results, err := t.traceBlock(block, calltracer.NewCallTracer(calltracer.Config{ContinueOnError: true}))
+	if err != nil {
+		return nil, err
+	}
+
+	for idx, res := range results {
+		txTraces := flattenCallTrace(res, nil, make([]*flatTrace, 0))
+		setTraceLocation(txTraces, block, block.Transactions[idx].Hash(), idx)
+
+		traces = append(traces, txTraces...)
+	}
+
+	return traces, nil
+}
+
+func (t *Trace) traceBlock(block *types.Block, tracer tracer.Tracer) ([]interface{}, error) {
+	cancel := cancelOnTimeout(tracer, defaultTraceTimeout)
+	defer cancel()
+
+	return t.store.TraceBlock(block, tracer)
+}
+
+type flatTraceAction struct {
+	CallType string `json:"callType,omitempty"`
+	From     string `json:"from"`
+	To       string `json:"to,omitempty"`
+	Gas      string `json:"gas"`
+	Input    string `json:"input,omitempty"`
+	Init     string `json:"init,omitempty"`
+	Value    string `json:"value"`
+}
+
+type flatTraceResult struct {
+	GasUsed string `json:"gasUsed"`
+	Output  string `json:"output,omitempty"`
+	Address string `json:"address,omitempty"`
+	Code    string `json:"code,omitempty"`
+}
+
+type flatTrace struct {
+	Action              flatTraceAction  `json:"action"`
+	BlockHash           *types.Hash      `json:"blockHash,omitempty"`
+	BlockNumber         *uint64          `json:"blockNumber,omitempty"`
+	Error               string           `json:"error,omitempty"`
+	Result              *flatTraceResult `json:"result"`
+	Subtraces           int              `json:"subtraces"`
+	TraceAddress        []int            `json:"traceAddress"`
+	TransactionHash     *types.Hash      `json:"transactionHash,omitempty"`
+	TransactionPosition *uint64          `json:"transactionPosition,omitempty"`
+	Type                string           `json:"type"`
+}
+
+// matches returns true if the trace matches the sender and receiver address sets.
+// An empty set matches any address
+func (f *flatTrace) matches(fromAddresses, toAddresses map[types.Address]struct{}) bool {
+	if len(fromAddresses) > 0 {
+		if _, ok := fromAddresses[types.StringToAddress(f.Action.From)]; !ok {
+			return false
+		}
+	}
+
+	if len(toAddresses) > 0 {
+		to := f.Action.To
+		if f.Type == flatTraceTypeCreate && f.Result != nil {
+			to = f.Result.Address
+		}
+
+		if to == "" {
+			return false
+		}
+
+		if _, ok := toAddresses[types.StringToAddress(to)]; !ok {
+			return false
+		}
+	}
+
+	return true
+}
+
+type traceReplayResult struct {
+	Output          string                              `json:"output"`
+	StateDiff       map[types.Address]*stateDiffAccount `json:"stateDiff"`
+	Trace           []*flatTrace                        `json:"trace"`
+	VMTrace         interface{}                         `json:"vmTrace"`
+	TransactionHash types.Hash                          `json:"transactionHash"`
+}
+
+// stateDiffAccount holds the changes of a single account. Each field is either
+// "=" (unchanged), {"+": value} (created), {"-": value} (deleted)
+// or {"*": {"from": value, "to": value}} (modified)
+type stateDiffAccount struct {
+	Balance interface{}                `json:"balance"`
+	Nonce   interface{}                `json:"nonce"`
+	Code    interface{}                `json:"code"`
+	Storage map[types.Hash]interface{} `json:"storage"`
+}
+
+const diffUnchanged = "="
+
+func diffBorn(value string) map[string]string {
+	return map[string]string{"+": value}
+}
+
+func diffDied(value string) map[string]string {
+	return map[string]string{"-": value}
+}
+
+func diffChanged(from, to string) map[string]map[string]string {
+	return map[string]map[string]string{"*": {"from": from, "to": to}}
+}
+
+// flattenCallTrace converts the call tree returned by the call tracer
+// into the list of flat traces in the depth-first order
+func flattenCallTrace(res interface{}, traceAddress []int, traces []*flatTrace) []*flatTrace {
+	call, ok := res.(*calltracer.Call)
+	if !ok || call == nil {
+		return traces
+	}
+
+	trace := &flatTrace{
+		Action: flatTraceAction{
+			From:  call.From,
+			Gas:   call.Gas,
+			Value: call.Value,
+		},
+		Error:        call.Error,
+		Subtraces:    len(call.Calls),
+		TraceAddress: append(make([]int, 0, len(traceAddress)), traceAddress...),
+	}
+
+	if trace.Action.Value == "" {
+		trace.Action.Value = "0x0"
+	}
+
+	if call.Type == "CREATE" || call.Type == "CREATE2" {
+		trace.Type = flatTraceTypeCreate
+		trace.Action.Init = call.Input
+
+		if call.Error == "" {
+			trace.Result = &flatTraceResult{
+				GasUsed: call.GasUsed,
+				Address: call.To,
+				Code:    call.Output,
+			}
+		}
+	} else {
+		trace.Type = flatTraceTypeCall
+		trace.Action.CallType = strings.ToLower(call.Type)
+		trace.Action.To = call.To
+		trace.Action.Input = call.Input
+
+		if call.Error == "" {
+			trace.Result = &flatTraceResult{
+				GasUsed: call.GasUsed,
+				Output:  call.Output,
+			}
+		}
+	}
+
+	traces = append(traces, trace)
+
+	for idx, subcall := range call.Calls {
+		traces = flattenCallTrace(subcall, append(traceAddress, idx), traces)
+	}
+
+	return traces
+}
+
+// setTraceLocation sets the block and transaction the traces belong to
+func setTraceLocation(traces []*flatTrace, block *types.Block, txHash types.Hash, txIndex int) {
+	blockHash := block.Hash()
+	blockNumber := block.Number()
+	txPosition := uint64(txIndex)
+
+	for _, trace := range traces {
+		trace.BlockHash = &blockHash
+		trace.BlockNumber = &blockNumber
+		trace.TransactionHash = &txHash
+		trace.TransactionPosition = &txPosition
+	}
+}
+
+// toStateDiff converts the result of the prestate tracer in the diff mode into the state diff
+func toStateDiff(diff *prestatetracer.DiffResult) map[types.Address]*stateDiffAccount {
+	res := make(map[types.Address]*stateDiffAccount, len(diff.Pre)+len(diff.Post))
+
+	for addr, post := range diff.Post {
+		pre, existed := diff.Pre[addr]
+		if !existed {
+			acc := &stateDiffAccount{
+				Balance: diffBorn(orDefault(post.Balance, "0x0")),
+				Nonce:   diffBorn(hex.EncodeUint64(post.Nonce)),
+				Code:    diffBorn(orDefault(post.Code, "0x")),
+				Storage: make(map[types.Hash]interface{}, len(post.Storage)),
+			}
+
+			for slot, value := range post.Storage {
+				acc.Storage[slot] = diffBorn(value.String())
+			}
+
+			res[addr] = acc
+
+			continue
+		}
+
+		// the post state of the prestate tracer contains only the modified fields
+		acc := &stateDiffAccount{
+			Balance: diffUnchanged,
+			Nonce:   diffUnchanged,
+			Code:    diffUnchanged,
+			Storage: make(map[types.Hash]interface{}, len(pre.Storage)),
+		}
+
+		if post.Balance != "" {
+			acc.Balance = diffChanged(orDefault(pre.Balance, "0x0"), post.Balance)
+		}
+
+		if post.Nonce != 0 && post.Nonce != pre.Nonce {
+			acc.Nonce = diffChanged(hex.EncodeUint64(pre.Nonce), hex.EncodeUint64(post.Nonce))
+		}
+
+		if post.Code != "" {
+			acc.Code = diffChanged(orDefault(pre.Code, "0x"), post.Code)
+		}
+
+		// the prestate contains only the modified slots and zero values are omitted from the post state
+		for slot, value := range pre.Storage {
+			acc.Storage[slot] = diffChanged(value.String(), post.Storage[slot].String())
+		}
+
+		res[addr] = acc
+	}
+
+	for addr, pre := range diff.Pre {
+		if _, ok := diff.Post[addr]; ok {
+			continue
+		}
+
+		acc := &stateDiffAccount{
+			Balance: diffDied(orDefault(pre.Balance, "0x0")),
+			Nonce:   diffDied(hex.EncodeUint64(pre.Nonce)),
+			Code:    diffDied(orDefault(pre.Code, "0x")),
+			Storage: make(map[types.Hash]interface{}, len(pre.Storage)),
+		}
+
+		for slot, value := range pre.Storage {
+			acc.Storage[slot] = diffDied(value.String())
+		}
+
+		res[addr] = acc
+	}
+
+	return res
+}
+
+func toAddressSet(addresses []types.Address) map[types.Address]struct{} {
+	set := make(map[types.Address]struct{}, len(addresses))
+
+	for _, addr := range addresses {
+		set[addr] = struct{}{}
+	}
+
+	return set
+}
+
+func orDefault(value, def string) string {
+	if value == "" {
+		return def
+	}
+
+	return value
+}
@@ -0,0 +1,387 @@
+package jsonrpc
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/calltracer"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer/prestatetracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	traceAddr1 = types.StringToAddress("0x1")
+	traceAddr2 = types.StringToAddress("0x2")
+	traceAddr3 = types.StringToAddress("0x3")
+	traceAddr4 = types.StringToAddress("0x4")
+
+	testTraceTxHash2 = types.BytesToHash([]byte{2})
+	testTraceBlock   = &types.Block{
+		Header: createTestHeader(5, nil),
+		Transactions: []*types.Transaction{
+			createTestTransaction(testTxHash1),
+			createTestTransaction(testTraceTxHash2),
+		},
+	}
+)
+
+// newTestCallTraces returns the call tracer results of the transactions in testTraceBlock
+func newTestCallTraces() []interface{} {
+	return []interface{}{
+		&calltracer.Call{
+			Type:    "CALL",
+			From:    traceAddr1.String(),
+			To:      traceAddr2.String(),
+			Value:   "0x64",
+			Gas:     "0x1000",
+			GasUsed: "0x500",
+			Input:   "0x01",
+			Output:  "0x02",
+			Calls: []*calltracer.Call{
+				{
+					Type:    "STATICCALL",
+					From:    traceAddr2.String(),
+					To:      traceAddr3.String(),
+					Gas:     "0x100",
+					GasUsed: "0x10",
+					Input:   "0x03",
+					Output:  "0x",
+				},
+				{
+					Type:    "CREATE",
+					From:    traceAddr2.String(),
+					To:      traceAddr4.String(),
+					Value:   "0x0",
+					Gas:     "0x200",
+					GasUsed: "0x20",
+					Input:   "0x6080",
+					Output:  "0x60",
+				},
+			},
+		},
+		&calltracer.Call{
+			Type:    "CALL",
+			From:    traceAddr3.String(),
+			To:      traceAddr2.String(),
+			Value:   "0x0",
+			Gas:     "0x1000",
+			GasUsed: "0x1000",
+			Input:   "0x",
+			Output:  "0x",
+			Error:   "execution reverted",
+		},
+	}
+}
+
+func newTestTraceStore(t *testing.T) *debugEndpointMockStore {
+	t.Helper()
+
+	return &debugEndpointMockStore{
+		headerFn: func() *types.Header {
+			return testTraceBlock.Header
+		},
+		getBlockByNumberFn: func(num uint64, full bool) (*types.Block, bool) {
+			if num == 0 {
+				return testGenesisBlock, true
+			}
+
+			return &types.Block{
+				Header:       createTestHeader(num, nil),
+				Transactions: testTraceBlock.Transactions,
+			}, true
+		},
+		traceBlockFn: func(block *types.Block, tr tracer.Tracer) ([]interface{}, error) {
+			if _, ok := tr.(*prestatetracer.PrestateTracer); ok {
+				return []interface{}{
+					&prestatetracer.DiffResult{
+						Pre: map[types.Address]*prestatetracer.Account{
+							traceAddr1: {Balance: "0x100", Nonce: 1},
+						},
+						Post: map[types.Address]*prestatetracer.Account{
+							traceAddr1: {Balance: "0x9c"},
+							traceAddr4: {Nonce: 1, Code: "0x60"},
+						},
+					},
+					&prestatetracer.DiffResult{
+						Pre:  map[types.Address]*prestatetracer.Account{},
+						Post: map[types.Address]*prestatetracer.Account{},
+					},
+				}, nil
+			}
+
+			callTracer, ok := tr.(*calltracer.CallTracer)
+			assert.True(t, ok)
+			assert.True(t, callTracer.Config.ContinueOnError)
+
+			return newTestCallTraces(), nil
+		},
+	}
+}
+
+func TestTrace_Block(t *testing.T) {
+	t.Parallel()
+
+	endpoint := NewTrace(newTestTraceStore(t), 1, 0)
+
+	res, err := endpoint.Block(BlockNumber(5))
+	require.NoError(t, err)
+
+	traces, ok := res.([]*flatTrace)
+	require.True(t, ok)
+	require.Len(t, traces, 4)
+
+	// top level call
+	assert.Equal(t, flatTraceTypeCall, traces[0].Type)
+	assert.Equal(t, "call", traces[0].Action.CallType)
+	assert.Equal(t, traceAddr2.String(), traces[0].Action.To)
+	assert.Equal(t, 2, traces[0].Subtraces)
+	assert.Equal(t, []int{}, traces[0].TraceAddress)
+	assert.Equal(t, &flatTraceResult{GasUsed: "0x500", Output: "0x02"}, traces[0].Result)
+
+	// static call
+	assert.Equal(t, "staticcall", traces[1].Action.CallType)
+	assert.Equal(t, "0x0", traces[1].Action.Value)
+	assert.Equal(t, []int{0}, traces[1].TraceAddress)
+
+	// contract creation
+	assert.Equal(t, flatTraceTypeCreate, traces[2].Type)
+	assert.Equal(t, "0x6080", traces[2].Action.Init)
+	assert.Empty(t, traces[2].Action.To)
+	assert.Equal(t, []int{1}, traces[2].TraceAddress)
+	assert.Equal(t, &flatTraceResult{GasUsed: "0x20", Address: traceAddr4.String(), Code: "0x60"}, traces[2].Result)
+
+	// failed transaction
+	assert.Equal(t, "execution reverted", traces[3].Error)
+	assert.Nil(t, traces[3].Result)
+	assert.Equal(t, testTraceTxHash2, *traces[3].TransactionHash)
+	assert.Equal(t, uint64(1), *traces[3].TransactionPosition)
+	assert.Equal(t, uint64(5), *traces[3].BlockNumber)
+
+	// genesis block has no traces
+	res, err = endpoint.Block(BlockNumber(0))
+	require.NoError(t, err)
+	assert.Empty(t, res)
+}
+
+func TestTrace_Transaction(t *testing.T) {
+	t.Parallel()
+
+	store := newTestTraceStore(t)
+	store.readTxLookupFn = func(hash types.Hash) (types.Hash, bool) {
+		return testTraceBlock.Hash(), true
+	}
+	store.getBlockByHashFn = func(hash types.Hash, full bool) (*types.Block, bool) {
+		return testTraceBlock, true
+	}
+	store.traceTxnFn = func(block *types.Block, txHash types.Hash, tr tracer.Tracer) (interface{}, error) {
+		assert.Equal(t, testTraceTxHash2, txHash)
+
+		return newTestCallTraces()[1], nil
+	}
+
+	endpoint := NewTrace(store, 1, 0)
+
+	res, err := endpoint.Transaction(testTraceTxHash2)
+	require.NoError(t, err)
+
+	traces, ok := res.([]*flatTrace)
+	require.True(t, ok)
+	require.Len(t, traces, 1)
+	assert.Equal(t, testTraceTxHash2, *traces[0].TransactionHash)
+	assert.Equal(t, uint64(1), *traces[0].TransactionPosition)
+	assert.Equal(t, testTraceBlock.Hash(), *traces[0].BlockHash)
+}
+
+func TestTrace_Filter(t *testing.T) {
+	t.Parallel()
+
+	blockNumberPtr := func(num BlockNumber) *BlockNumber {
+		return &num
+	}
+
+	tests := []struct {
+		name          string
+		filter        TraceFilterRequest
+		expectedCount int
+		err           error
+	}{
+		{
+			name: "should return all the traces in the range",
+			filter: TraceFilterRequest{
+				FromBlock: blockNumberPtr(1),
+				ToBlock:   blockNumberPtr(3),
+			},
+			expectedCount: 12,
+		},
+		{
+			name: "should filter by sender",
+			filter: TraceFilterRequest{
+				FromBlock:   blockNumberPtr(1),
+				ToBlock:     blockNumberPtr(3),
+				FromAddress: []types.Address{traceAddr2},
+			},
+			expectedCount: 6,
+		},
+		{
+			name: "should filter by receiver and created contract",
+			filter: TraceFilterRequest{
+				FromBlock: blockNumberPtr(1),
+				ToBlock:   blockNumberPtr(2),
+				ToAddress: []types.Address{traceAddr4},
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "should filter by sender and receiver",
+			filter: TraceFilterRequest{
+				FromBlock:   blockNumberPtr(1),
+				ToBlock:     blockNumberPtr(1),
+				FromAddress: []types.Address{traceAddr1, traceAddr3},
+				ToAddress:   []types.Address{traceAddr2},
+			},
+			expectedCount: 2,
+		},
+		{
+			name: "should paginate the traces",
+			filter: TraceFilterRequest{
+				FromBlock: blockNumberPtr(1),
+				ToBlock:   blockNumberPtr(3),
+				After:     argUintPtr(2),
+				Count:     argUintPtr(5),
+			},
+			expectedCount: 5,
+		},
+		{
+			name: "should fail on incorrect range",
+			filter: TraceFilterRequest{
+				FromBlock: blockNumberPtr(3),
+				ToBlock:   blockNumberPtr(1),
+			},
+			err: ErrIncorrectBlockRange,
+		},
+		{
+			name: "should fail if the range exceeds the limit",
+			filter: TraceFilterRequest{
+				FromBlock: blockNumberPtr(1),
+				ToBlock:   blockNumberPtr(20),
+			},
+			err: ErrBlockRangeTooHigh,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			endpoint := NewTrace(newTestTraceStore(t), 1, 10)
+
+			res, err := endpoint.Filter(test.filter)
+			if test.err != nil {
+				require.ErrorIs(t, err, test.err)
+
+				return
+			}
+
+			require.NoError(t, err)
+
+			traces, ok := res.([]*flatTrace)
+			require.True(t, ok)
+			assert.Len(t, traces, test.expectedCount)
+		})
+	}
+}
+
+func TestTrace_ReplayBlockTransactions(t *testing.T) {
+	t.Parallel()
+
+	endpoint := NewTrace(newTestTraceStore(t), 1, 0)
+
+	_, err := endpoint.ReplayBlockTransactions(BlockNumber(5), []string{"unknown"})
+	require.ErrorIs(t, err, ErrUnknownTraceType)
+
+	res, err := endpoint.ReplayBlockTransactions(BlockNumber(5), []string{traceTypeTrace, traceTypeStateDiff})
+	require.NoError(t, err)
+
+	results, ok := res.([]*traceReplayResult)
+	require.True(t, ok)
+	require.Len(t, results, 2)
+
+	assert.Equal(t, "0x02", results[0].Output)
+	assert.Equal(t, testTxHash1, results[0].TransactionHash)
+	assert.Len(t, results[0].Trace, 3)
+	assert.Nil(t, results[0].Trace[0].BlockNumber)
+
+	assert.Equal(t, map[types.Address]*stateDiffAccount{
+		traceAddr1: {
+			Balance: diffChanged("0x100", "0x9c"),
+			Nonce:   diffUnchanged,
+			Code:    diffUnchanged,
+			Storage: map[types.Hash]interface{}{},
+		},
+		traceAddr4: {
+			Balance: diffBorn("0x0"),
+			Nonce:   diffBorn("0x1"),
+			Code:    diffBorn("0x60"),
+			Storage: map[types.Hash]interface{}{},
+		},
+	}, results[0].StateDiff)
+
+	assert.Empty(t, results[1].StateDiff)
+
+	// state diff is not computed unless requested
+	res, err = endpoint.ReplayBlockTransactions(BlockNumber(5), []string{traceTypeTrace})
+	require.NoError(t, err)
+	assert.Nil(t, res.([]*traceReplayResult)[0].StateDiff) //nolint:forcetypeassert
+}
+
+func TestToStateDiff(t *testing.T) {
+	t.Parallel()
+
+	slot1 := types.StringToHash("0x1")
+	slot2 := types.StringToHash("0x2")
+
+	diff := toStateDiff(&prestatetracer.DiffResult{
+		Pre: map[types.Address]*prestatetracer.Account{
+			traceAddr1: {
+				Balance: "0x10",
+				Nonce:   1,
+				Storage: map[types.Hash]types.Hash{
+					slot1: types.StringToHash("0x5"),
+					slot2: types.StringToHash("0x6"),
+				},
+			},
+			traceAddr2: {Balance: "0x1", Code: "0x60"},
+		},
+		Post: map[types.Address]*prestatetracer.Account{
+			traceAddr1: {
+				Nonce: 2,
+				Storage: map[types.Hash]types.Hash{
+					slot1: types.StringToHash("0x7"),
+				},
+			},
+		},
+	})
+
+	assert.Equal(t, map[types.Address]*stateDiffAccount{
+		traceAddr1: {
+			Balance: diffUnchanged,
+			Nonce:   diffChanged("0x1", "0x2"),
+			Code:    diffUnchanged,
+			Storage: map[types.Hash]interface{}{
+				slot1: diffChanged(types.StringToHash("0x5").String(), types.StringToHash("0x7").String()),
+				slot2: diffChanged(types.StringToHash("0x6").String(), types.ZeroHash.String()),
+			},
+		},
+		traceAddr2: {
+			Balance: diffDied("0x1"),
+			Nonce:   diffDied("0x0"),
+			Code:    diffDied("0x60"),
+			Storage: map[types.Hash]interface{}{},
+		},
+	}, diff)
+}
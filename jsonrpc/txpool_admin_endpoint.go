@@ -0,0 +1,42 @@
+package jsonrpc
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// txPoolAdminStore provides access to the methods needed for txpool admin endpoint
+type txPoolAdminStore interface {
+	// RemoveTx removes the transaction along with the higher nonce transactions of its account,
+	// returns the number of removed transactions
+	RemoveTx(hash types.Hash) int
+
+	// DropAccount removes all the transactions of the account and reverts its nonce to the state one,
+	// returns the number of removed transactions
+	DropAccount(addr types.Address) int
+}
+
+// TxPoolAdmin is the txpooladmin jsonrpc endpoint with the methods modifying the pool.
+// It is served only if it is explicitly enabled
+type TxPoolAdmin struct {
+	adminStore txPoolAdminStore
+}
+
+type RemoveResponse struct {
+	Removed uint64 `json:"removed"`
+}
+
+// Create response for txpooladmin_removeTransaction request.
+// Removes the transaction and the transactions of the same account with the higher nonces.
+func (t *TxPoolAdmin) RemoveTransaction(hash types.Hash) (interface{}, error) {
+	removed := t.adminStore.RemoveTx(hash)
+
+	return RemoveResponse{Removed: uint64(removed)}, nil
+}
+
+// Create response for txpooladmin_dropAccount request.
+// Removes all the transactions of the account and reverts its nonce to the one from the state.
+func (t *TxPoolAdmin) DropAccount(addr types.Address) (interface{}, error) {
+	removed := t.adminStore.DropAccount(addr)
+
+	return RemoveResponse{Removed: uint64(removed)}, nil
+}
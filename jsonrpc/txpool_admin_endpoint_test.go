@@ -0,0 +1,110 @@
+package jsonrpc
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTxPoolAdminEndpoint(t *testing.T) {
+	t.Parallel()
+
+	store := newMockTxPoolAdminStore()
+	store.removed = 3
+	endpoint := &TxPoolAdmin{store}
+
+	hash := types.StringToHash("0x1")
+
+	result, err := endpoint.RemoveTransaction(hash)
+	require.NoError(t, err)
+	assert.Equal(t, RemoveResponse{Removed: 3}, result)
+	assert.Equal(t, hash, store.removedHash)
+
+	result, err = endpoint.DropAccount(addr1)
+	require.NoError(t, err)
+	assert.Equal(t, RemoveResponse{Removed: 3}, result)
+	assert.Equal(t, addr1, store.droppedAddr)
+}
+
+func TestDispatcher_TxPoolAdmin(t *testing.T) {
+	t.Parallel()
+
+	req := []byte(fmt.Sprintf(`{"id": 1, "method": "txpooladmin_dropAccount", "params": ["%s"]}`, addr1))
+
+	t.Run("admin methods are not served by default", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockTxPoolAdminStore()
+		dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{})
+
+		res, err := dispatcher.Handle(req)
+		require.NoError(t, err)
+
+		var resp ErrorResponse
+		require.NoError(t, jsonIt.Unmarshal(res, &resp))
+		require.NotNil(t, resp.Error)
+		assert.Equal(t, NewMethodNotFoundError("").ErrorCode(), resp.Error.Code)
+		assert.Equal(t, types.ZeroAddress, store.droppedAddr)
+
+		// the admin methods are not served under the txpool namespace either
+		_, _, rpcErr := dispatcher.getFnHandler(Request{Method: "txpool_dropAccount"})
+		assert.NotNil(t, rpcErr)
+	})
+
+	t.Run("admin methods are served if enabled", func(t *testing.T) {
+		t.Parallel()
+
+		store := newMockTxPoolAdminStore()
+		store.removed = 2
+		dispatcher := newTestDispatcher(t, hclog.NewNullLogger(), store, &dispatcherParams{txPoolAdmin: true})
+
+		res, err := dispatcher.Handle(req)
+		require.NoError(t, err)
+
+		var resp RemoveResponse
+		require.NoError(t, expectJSONResult(res, &resp))
+		assert.Equal(t, uint64(2), resp.Removed)
+		assert.Equal(t, addr1, store.droppedAddr)
+
+		// the read only methods are still served under the txpool namespace only
+		_, _, rpcErr := dispatcher.getFnHandler(Request{Method: "txpool_contentFrom"})
+		assert.Nil(t, rpcErr)
+
+		_, _, rpcErr = dispatcher.getFnHandler(Request{Method: "txpool_dropAccount"})
+		assert.NotNil(t, rpcErr)
+
+		_, _, rpcErr = dispatcher.getFnHandler(Request{Method: "txpooladmin_contentFrom"})
+		assert.NotNil(t, rpcErr)
+	})
+}
+
+type mockTxPoolAdminStore struct {
+	*mockStore
+
+	removed     int
+	removedHash types.Hash
+	droppedAddr types.Address
+}
+
+func newMockTxPoolAdminStore() *mockTxPoolAdminStore {
+	return &mockTxPoolAdminStore{
+		mockStore: newMockStore(),
+	}
+}
+
+func (s *mockTxPoolAdminStore) RemoveTx(hash types.Hash) int {
+	s.removedHash = hash
+
+	return s.removed
+}
+
+func (s *mockTxPoolAdminStore) DropAccount(addr types.Address) int {
+	s.droppedAddr = addr
+
+	return s.removed
+}
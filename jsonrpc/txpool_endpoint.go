@@ -17,6 +17,27 @@ type txPoolStore interface {
 
 	// GetBaseFee returns current base fee
 	GetBaseFee() uint64
+
+	// GetAccountTxs gets tx pool transactions of the account pending for inclusion and queued for validation
+	GetAccountTxs(addr types.Address) ([]*types.Transaction, []*types.Transaction)
+
+	// GetTxLifecycle returns the lifecycle of the transaction in the pool
+	GetTxLifecycle(hash types.Hash) (*TxLifecycle, bool)
+}
+
+// TxLifecycle is the history of a transaction in the pool
+type TxLifecycle struct {
+	Events    []TxLifecycleEvent
+	Demotions uint64
+	InPool    bool
+	NonceGap  uint64
+}
+
+// TxLifecycleEvent is a single status transition of a transaction in the pool
+type TxLifecycleEvent struct {
+	Status    string `json:"status"`
+	Reason    string `json:"reason,omitempty"`
+	Timestamp uint64 `json:"timestamp"`
 }
 
 // TxPool is the txpool jsonrpc endpoint
@@ -29,6 +50,11 @@ type ContentResponse struct {
 	Queued  map[types.Address]map[uint64]*transaction `json:"queued"`
 }
 
+type ContentFromResponse struct {
+	Pending map[uint64]*transaction `json:"pending"`
+	Queued  map[uint64]*transaction `json:"queued"`
+}
+
 type InspectResponse struct {
 	Pending         map[string]map[string]string `json:"pending"`
 	Queued          map[string]map[string]string `json:"queued"`
@@ -41,6 +67,14 @@ type StatusResponse struct {
 	Queued  uint64 `json:"queued"`
 }
 
+type TxStatusResponse struct {
+	Status    string             `json:"status"`
+	InPool    bool               `json:"inPool"`
+	Demotions uint64             `json:"demotions"`
+	NonceGap  uint64             `json:"nonceGap"`
+	History   []TxLifecycleEvent `json:"history"`
+}
+
 // Create response for txpool_content request.
 // See https://geth.ethereum.org/docs/rpc/ns-txpool#txpool_content.
 func (t *TxPool) Content() (interface{}, error) {
@@ -48,11 +82,7 @@ func (t *TxPool) Content() (interface{}, error) {
 		result := make(map[types.Address]map[uint64]*transaction, len(txMap))
 
 		for addr, txs := range txMap {
-			result[addr] = make(map[uint64]*transaction, len(txs))
-
-			for _, tx := range txs {
-				result[addr][tx.Nonce()] = toTransaction(tx, nil, &types.ZeroHash, nil)
-			}
+			result[addr] = toNonceTransactionMap(txs)
 		}
 
 		return result
@@ -67,6 +97,18 @@ func (t *TxPool) Content() (interface{}, error) {
 	return resp, nil
 }
 
+// Create response for txpool_contentFrom request.
+// See https://geth.ethereum.org/docs/interacting-with-geth/rpc/ns-txpool#txpool-contentfrom.
+func (t *TxPool) ContentFrom(addr types.Address) (interface{}, error) {
+	pendingTxs, queuedTxs := t.store.GetAccountTxs(addr)
+	resp := ContentFromResponse{
+		Pending: toNonceTransactionMap(pendingTxs),
+		Queued:  toNonceTransactionMap(queuedTxs),
+	}
+
+	return resp, nil
+}
+
 // Create response for txpool_inspect request.
 // See https://geth.ethereum.org/docs/rpc/ns-txpool#txpool_inspect.
 func (t *TxPool) Inspect() (interface{}, error) {
@@ -125,3 +167,34 @@ func (t *TxPool) Status() (interface{}, error) {
 
 	return resp, nil
 }
+
+// Create response for txpool_txStatus request.
+// Returns the latest status of the transaction along with its history in the pool,
+// or nil if the transaction is not tracked by the pool
+func (t *TxPool) TxStatus(hash types.Hash) (interface{}, error) {
+	lifecycle, ok := t.store.GetTxLifecycle(hash)
+	if !ok || len(lifecycle.Events) == 0 {
+		return nil, nil
+	}
+
+	resp := TxStatusResponse{
+		Status:    lifecycle.Events[len(lifecycle.Events)-1].Status,
+		InPool:    lifecycle.InPool,
+		Demotions: lifecycle.Demotions,
+		NonceGap:  lifecycle.NonceGap,
+		History:   lifecycle.Events,
+	}
+
+	return resp, nil
+}
+
+// toNonceTransactionMap maps the nonces of the pool transactions to their json representation
+func toNonceTransactionMap(txs []*types.Transaction) map[uint64]*transaction {
+	result := make(map[uint64]*transaction, len(txs))
+
+	for _, tx := range txs {
+		result[tx.Nonce()] = toTransaction(tx, nil, &types.ZeroHash, nil)
+	}
+
+	return result
+}
@@ -263,6 +263,71 @@ func TestStatusEndpoint(t *testing.T) {
 	})
 }
 
+func TestContentFromEndpoint(t *testing.T) {
+	t.Parallel()
+
+	mockStore := newMockTxPoolStore()
+	address1 := types.Address{0x1}
+	address2 := types.Address{0x2}
+	testTx1 := newTestTransaction(2, address1)
+	testTx2 := newTestDynamicFeeTransaction(5, address1)
+	testTx3 := newTestTransaction(0, address2)
+	mockStore.pending[address1] = []*types.Transaction{testTx1}
+	mockStore.queued[address1] = []*types.Transaction{testTx2}
+	mockStore.pending[address2] = []*types.Transaction{testTx3}
+	txPoolEndpoint := &TxPool{mockStore}
+
+	result, err := txPoolEndpoint.ContentFrom(address1)
+	assert.NoError(t, err)
+
+	response := result.(ContentFromResponse)
+
+	assert.Equal(t, 1, len(response.Pending))
+	assert.Equal(t, 1, len(response.Queued))
+	assert.Equal(t, testTx1.Hash(), response.Pending[2].Hash)
+	assert.Equal(t, testTx2.Hash(), response.Queued[5].Hash)
+
+	result, err = txPoolEndpoint.ContentFrom(types.Address{0x3})
+	assert.NoError(t, err)
+
+	response = result.(ContentFromResponse)
+
+	assert.Equal(t, 0, len(response.Pending))
+	assert.Equal(t, 0, len(response.Queued))
+}
+
+func TestTxStatusEndpoint(t *testing.T) {
+	t.Parallel()
+
+	hash := types.StringToHash("0x1")
+	events := []TxLifecycleEvent{
+		{Status: "ADDED", Timestamp: 1},
+		{Status: "DEMOTED", Timestamp: 2},
+		{Status: "DROPPED", Reason: "account demoted too many times", Timestamp: 3},
+	}
+
+	mockStore := newMockTxPoolStore()
+	mockStore.lifecycles = map[types.Hash]*TxLifecycle{
+		hash: {Events: events, Demotions: 1},
+	}
+	txPoolEndpoint := &TxPool{mockStore}
+
+	result, err := txPoolEndpoint.TxStatus(hash)
+	assert.NoError(t, err)
+
+	response := result.(TxStatusResponse)
+
+	assert.Equal(t, "DROPPED", response.Status)
+	assert.False(t, response.InPool)
+	assert.Equal(t, uint64(1), response.Demotions)
+	assert.Equal(t, events, response.History)
+
+	// the transaction not tracked by the pool
+	result, err = txPoolEndpoint.TxStatus(types.StringToHash("0x2"))
+	assert.NoError(t, err)
+	assert.Nil(t, result)
+}
+
 type mockTxPoolStore struct {
 	pending       map[types.Address][]*types.Transaction
 	queued        map[types.Address][]*types.Transaction
@@ -270,6 +335,7 @@ type mockTxPoolStore struct {
 	maxSlots      uint64
 	baseFee       uint64
 	includeQueued bool
+	lifecycles    map[types.Hash]*TxLifecycle
 }
 
 func newMockTxPoolStore() *mockTxPoolStore {
@@ -293,6 +359,16 @@ func (s *mockTxPoolStore) GetBaseFee() uint64 {
 	return s.baseFee
 }
 
+func (s *mockTxPoolStore) GetAccountTxs(addr types.Address) ([]*types.Transaction, []*types.Transaction) {
+	return s.pending[addr], s.queued[addr]
+}
+
+func (s *mockTxPoolStore) GetTxLifecycle(hash types.Hash) (*TxLifecycle, bool) {
+	lifecycle, ok := s.lifecycles[hash]
+
+	return lifecycle, ok
+}
+
 func newTestTransaction(nonce uint64, from types.Address) *types.Transaction {
 	txn := types.NewTx(types.NewLegacyTx(
 		types.WithGasPrice(big.NewInt(1)),
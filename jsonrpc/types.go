@@ -1,6 +1,8 @@
 package jsonrpc
 
 import (
+	"encoding/json"
+	"errors"
 	"math/big"
 	"strconv"
 	"strings"
@@ -212,11 +214,13 @@ type receipt struct {
 	ContractAddress   *types.Address `json:"contractAddress"`
 	FromAddr          types.Address  `json:"from"`
 	ToAddr            *types.Address `json:"to"`
+	EffectiveGasPrice argBig         `json:"effectiveGasPrice"`
 }
 
 func toReceipt(src *types.Receipt, tx *types.Transaction,
 	txIndex uint64, header *types.Header, logs []*Log) *receipt {
 	return &receipt{
+		EffectiveGasPrice: argBig(*tx.GetGasPrice(header.BaseFee)),
 		Root:              src.Root,
 		CumulativeGasUsed: argUint64(src.CumulativeGasUsed),
 		LogsBloom:         src.LogsBloom,
@@ -267,6 +271,53 @@ func toLog(src *types.Log, logIdx, txIdx uint64, header *types.Header, txHash ty
 	}
 }
 
+type storageProof struct {
+	Key   types.Hash `json:"key"`
+	Value argBig     `json:"value"`
+	Proof []argBytes `json:"proof"`
+}
+
+type accountProof struct {
+	Address      types.Address  `json:"address"`
+	AccountProof []argBytes     `json:"accountProof"`
+	Balance      argBig         `json:"balance"`
+	CodeHash     types.Hash     `json:"codeHash"`
+	Nonce        argUint64      `json:"nonce"`
+	StorageHash  types.Hash     `json:"storageHash"`
+	StorageProof []storageProof `json:"storageProof"`
+}
+
+func toAccountProof(addr types.Address, src *AccountProof) *accountProof {
+	res := &accountProof{
+		Address:      addr,
+		AccountProof: toArgBytesSlice(src.Proof),
+		Balance:      argBig(*src.Balance),
+		CodeHash:     src.CodeHash,
+		Nonce:        argUint64(src.Nonce),
+		StorageHash:  src.StorageRoot,
+		StorageProof: make([]storageProof, len(src.StorageProof)),
+	}
+
+	for i, sp := range src.StorageProof {
+		res.StorageProof[i] = storageProof{
+			Key:   sp.Key,
+			Value: argBig(*new(big.Int).SetBytes(sp.Value)),
+			Proof: toArgBytesSlice(sp.Proof),
+		}
+	}
+
+	return res
+}
+
+func toArgBytesSlice(src [][]byte) []argBytes {
+	res := make([]argBytes, len(src))
+	for i, b := range src {
+		res[i] = argBytes(b)
+	}
+
+	return res
+}
+
 type argBig big.Int
 
 func argBigPtr(b *big.Int) *argBig {
@@ -396,6 +447,46 @@ type txnArgs struct {
 	AccessList *types.TxAccessList
 }
 
+// bundleArgs is the argument of eth_sendBundle
+type bundleArgs struct {
+	Txs          []argBytes `json:"txs"`
+	BlockNumber  argUint64  `json:"blockNumber"`
+	MinTimestamp *argUint64 `json:"minTimestamp"`
+	MaxTimestamp *argUint64 `json:"maxTimestamp"`
+}
+
+type sendBundleResult struct {
+	BundleHash types.Hash `json:"bundleHash"`
+}
+
+// txConditionsArgs is the conditions argument of eth_sendRawTransactionConditional
+type txConditionsArgs struct {
+	KnownAccounts  map[types.Address]knownAccount `json:"knownAccounts"`
+	BlockNumberMin *argUint64                     `json:"blockNumberMin"`
+	BlockNumberMax *argUint64                     `json:"blockNumberMax"`
+	TimestampMin   *argUint64                     `json:"timestampMin"`
+	TimestampMax   *argUint64                     `json:"timestampMax"`
+}
+
+// knownAccount is either the expected storage root of an account or the expected values of its storage slots,
+// only the storage slots are supported
+type knownAccount map[types.Hash]types.Hash
+
+func (k *knownAccount) UnmarshalJSON(data []byte) error {
+	if len(data) > 0 && data[0] == '"' {
+		return errors.New("storage root conditions are not supported, use the storage slots instead")
+	}
+
+	slots := map[types.Hash]types.Hash{}
+	if err := json.Unmarshal(data, &slots); err != nil {
+		return err
+	}
+
+	*k = slots
+
+	return nil
+}
+
 type progression struct {
 	Type          string    `json:"type"`
 	StartingBlock argUint64 `json:"startingBlock"`
@@ -403,6 +494,13 @@ type progression struct {
 	HighestBlock  argUint64 `json:"highestBlock"`
 }
 
+type accessListResult struct {
+	AccessList               types.TxAccessList `json:"accessList"`
+	GasUsed                  argUint64          `json:"gasUsed"`
+	GasUsedWithoutAccessList argUint64          `json:"gasUsedWithoutAccessList"`
+	Error                    string             `json:"error,omitempty"`
+}
+
 type feeHistoryResult struct {
 	OldestBlock   argUint64     `json:"oldestBlock"`
 	BaseFeePerGas []argUint64   `json:"baseFeePerGas,omitempty"`
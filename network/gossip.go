@@ -68,6 +68,20 @@ func (t *Topic) Publish(obj proto.Message) error {
 }
 
 func (t *Topic) Subscribe(handler func(obj interface{}, from peer.ID)) error {
+	return t.subscribe(func(obj interface{}, msg *pubsub.Message) {
+		handler(obj, msg.GetFrom())
+	})
+}
+
+// SubscribeDelivered runs the handler on every message of the topic with the peer
+// which has delivered the message, not necessarily the one which has published it
+func (t *Topic) SubscribeDelivered(handler func(obj interface{}, deliveredBy peer.ID)) error {
+	return t.subscribe(func(obj interface{}, msg *pubsub.Message) {
+		handler(obj, msg.ReceivedFrom)
+	})
+}
+
+func (t *Topic) subscribe(handler func(obj interface{}, msg *pubsub.Message)) error {
 	sub, err := t.topic.Subscribe(pubsub.WithBufferSize(subscribeOutputBufferSize))
 	if err != nil {
 		return err
@@ -81,7 +95,7 @@ func (t *Topic) Subscribe(handler func(obj interface{}, from peer.ID)) error {
 	return nil
 }
 
-func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{}, from peer.ID)) {
+func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{}, msg *pubsub.Message)) {
 	t.waitGroup.Add(1)
 	defer t.waitGroup.Done()
 
@@ -117,7 +131,7 @@ func (t *Topic) readLoop(sub *pubsub.Subscription, handler func(obj interface{},
 
 			metrics.SetGauge([]string{networkMetrics, "ingress_bytes"}, float32(len(msg.Data)))
 
-			handler(obj, msg.GetFrom())
+			handler(obj, msg)
 		}()
 	}
 }
@@ -98,6 +98,70 @@ func TestSimpleGossip(t *testing.T) {
 	}
 }
 
+func TestGossip_SubscribeDelivered(t *testing.T) {
+	noDiscover := &CreateServerParams{
+		ConfigCallback: func(c *Config) {
+			c.NoDiscover = true
+		},
+	}
+
+	// the servers are connected in a line, so the message reaches the last one through the middle one
+	servers, createErr := createServers(3, map[int]*CreateServerParams{0: noDiscover, 1: noDiscover, 2: noDiscover})
+	require.NoError(t, createErr, "Unable to create servers")
+
+	t.Cleanup(func() {
+		closeTestServers(t, servers)
+	})
+
+	require.NoError(t, JoinAndWait(servers[0], servers[1], DefaultBufferTimeout, DefaultJoinTimeout))
+	require.NoError(t, JoinAndWait(servers[1], servers[2], DefaultBufferTimeout, DefaultJoinTimeout))
+
+	topicName := "msg-relay"
+	topics := make([]*Topic, len(servers))
+
+	for i, server := range servers {
+		topic, topicErr := server.NewTopic(topicName, &testproto.GenericMessage{})
+		require.NoError(t, topicErr, "Unable to create topic")
+
+		topics[i] = topic
+	}
+
+	type senders struct {
+		from, deliveredBy peer.ID
+	}
+
+	sendersCh := make(chan senders, 1)
+	fromCh := make(chan peer.ID, 1)
+
+	// the middle server relays only the topics it is subscribed to
+	require.NoError(t, topics[1].Subscribe(func(_ interface{}, _ peer.ID) {}))
+	require.NoError(t, topics[2].Subscribe(func(_ interface{}, from peer.ID) {
+		fromCh <- from
+	}))
+	require.NoError(t, topics[2].SubscribeDelivered(func(_ interface{}, deliveredBy peer.ID) {
+		sendersCh <- senders{from: <-fromCh, deliveredBy: deliveredBy}
+	}))
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+
+	require.NoError(t, WaitForSubscribers(ctx, servers[0], topicName, 1))
+	require.NoError(t, WaitForSubscribers(ctx, servers[1], topicName, 1))
+
+	// wait for the gossip mesh to be formed
+	time.Sleep(2 * time.Second)
+
+	require.NoError(t, topics[0].Publish(&testproto.GenericMessage{Message: "relayed"}))
+
+	select {
+	case s := <-sendersCh:
+		require.Equal(t, servers[0].AddrInfo().ID, s.from)
+		require.Equal(t, servers[1].AddrInfo().ID, s.deliveredBy)
+	case <-time.After(15 * time.Second):
+		t.Fatalf("Relayed message not received before timeout")
+	}
+}
+
 func Test_RepeatedClose(t *testing.T) {
 	topic := &Topic{
 		closeCh: make(chan struct{}),
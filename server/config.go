@@ -9,6 +9,8 @@ import (
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/network"
 	"github.com/0xPolygon/polygon-edge/secrets"
+	"github.com/0xPolygon/polygon-edge/syncer"
+	"github.com/0xPolygon/polygon-edge/types"
 )
 
 const DefaultGRPCPort int = 9632
@@ -26,6 +28,13 @@ type Config struct {
 	MaxAccountEnqueued uint64
 	MaxSlots           uint64
 
+	TxPoolJournal   string
+	TxPoolRejournal time.Duration
+	TxPoolPriority  []types.Address
+
+	TxPoolSenderRate uint64
+	TxPoolPeerRate   uint64
+
 	Telemetry *Telemetry
 	Network   *network.Config
 
@@ -51,6 +60,19 @@ type Config struct {
 	MetricsInterval time.Duration
 
 	EventTracker *EventTracker
+
+	// StateRetention is the number of recent block states kept when pruning the state,
+	// 0 keeps the full archive of the states
+	StateRetention uint64
+
+	// FlatSnapshot enables serving the account and storage reads of the recent states from the flat snapshot
+	FlatSnapshot bool
+
+	// SyncMode defines how the node syncs with its peers
+	SyncMode syncer.SyncMode
+
+	// DBBackend is the database of the blockchain and state storages
+	DBBackend DBBackend
 }
 
 // Telemetry holds the config details for metric services
@@ -66,6 +88,7 @@ type JSONRPC struct {
 	BlockRangeLimit          uint64
 	ConcurrentRequestsDebug  uint64
 	WebSocketReadLimit       uint64
+	TxPoolAdmin              bool
 }
 
 type EventTracker struct {
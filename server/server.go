@@ -13,7 +13,6 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain/storage"
-	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
 	"github.com/0xPolygon/polygon-edge/blockchain/storage/memory"
 	consensusPolyBFT "github.com/0xPolygon/polygon-edge/consensus/polybft"
 	"github.com/0xPolygon/polygon-edge/forkmanager"
@@ -88,6 +87,9 @@ type Server struct {
 
 	// gasHelper is providing functions regarding gas and fees
 	gasHelper *gasprice.GasHelper
+
+	// statePruner removes the stale state if pruning is enabled
+	statePruner *statePruner
 }
 
 // newFileLogger returns logger instance that writes all logs to a specified file.
@@ -193,7 +195,7 @@ func NewServer(config *Config) (*Server, error) {
 	}
 
 	// start blockchain object
-	stateStorage, err := itrie.NewLevelDBStorage(filepath.Join(m.config.DataDir, "trie"), logger)
+	stateStorage, err := NewStateStorage(m.config.DBBackend, filepath.Join(m.config.DataDir, "trie"), logger)
 	if err != nil {
 		return nil, err
 	}
@@ -294,7 +296,8 @@ func NewServer(config *Config) (*Server, error) {
 				return nil, err
 			}
 		} else {
-			db, err = leveldb.NewLevelDBStorage(
+			db, err = NewBlockchainStorage(
+				m.config.DBBackend,
 				filepath.Join(m.config.DataDir, "blockchain"),
 				m.logger,
 			)
@@ -317,6 +320,11 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	if m.config.StateRetention > 0 {
+		m.statePruner = newStatePruner(logger, st, m.blockchain, m.config.StateRetention,
+			genesisRoot, initialStateRoot)
+	}
+
 	// here we can provide some other configuration
 	m.gasHelper, err = gasprice.NewGasHelper(gasprice.DefaultGasHelperConfig, m.blockchain)
 	if err != nil {
@@ -344,6 +352,11 @@ func NewServer(config *Config) (*Server, error) {
 				MaxAccountEnqueued: m.config.MaxAccountEnqueued,
 				ChainID:            big.NewInt(m.config.Chain.Params.ChainID),
 				PeerID:             m.network.AddrInfo().ID,
+				JournalPath:        m.txPoolJournalPath(),
+				RejournalInterval:  m.config.TxPoolRejournal,
+				PriorityAccounts:   m.config.TxPoolPriority,
+				SenderRateLimit:    m.config.TxPoolSenderRate,
+				PeerRateLimit:      m.config.TxPoolPeerRate,
 			},
 		)
 		if err != nil {
@@ -369,6 +382,17 @@ func NewServer(config *Config) (*Server, error) {
 		return nil, err
 	}
 
+	// serve the account and storage reads of the recent states from the flat snapshot.
+	// The state of the head block is missing if the state sync has been interrupted,
+	// so the flat snapshot is generated on the next start
+	if m.config.FlatSnapshot {
+		if _, err := st.NewSnapshotAt(m.blockchain.Header().StateRoot); errors.Is(err, state.ErrStateNotAvailable) {
+			m.logger.Warn("state of the latest block is not synced yet, flat state snapshot is disabled")
+		} else if err := st.EnableFlatSnapshot(m.blockchain.Header().StateRoot, m.logger); err != nil {
+			return nil, fmt.Errorf("failed to enable flat state snapshot: %w", err)
+		}
+	}
+
 	// initialize data in consensus layer
 	if err := m.consensus.Initialize(); err != nil {
 		return nil, err
@@ -401,6 +425,10 @@ func NewServer(config *Config) (*Server, error) {
 	m.txpool.SetBaseFee(m.blockchain.Header())
 	m.txpool.Start()
 
+	if m.statePruner != nil {
+		m.statePruner.start()
+	}
+
 	return m, nil
 }
 
@@ -418,6 +446,21 @@ func unaryInterceptor(
 	return handler(ctx, req)
 }
 
+// txPoolJournalPath returns the path of the local transactions journal, which is resolved
+// against the data directory if it's relative. The journal is disabled without a data directory
+func (s *Server) txPoolJournalPath() string {
+	path := s.config.TxPoolJournal
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+
+	if s.config.DataDir == "" {
+		return ""
+	}
+
+	return filepath.Join(s.config.DataDir, path)
+}
+
 func (s *Server) restoreChain() error {
 	if s.config.RestoreFile == nil {
 		return nil
@@ -464,6 +507,20 @@ func (t *txpoolHub) GetNonce(root types.Hash, addr types.Address) uint64 {
 	return account.Nonce
 }
 
+func (t *txpoolHub) GetStorage(root types.Hash, addr types.Address, slot types.Hash) types.Hash {
+	account, err := getAccountImpl(t.state, root, addr)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	snap, err := t.state.NewSnapshotAt(root)
+	if err != nil {
+		return types.ZeroHash
+	}
+
+	return snap.GetStorage(addr, account.Root, slot)
+}
+
 func (t *txpoolHub) GetBalance(root types.Hash, addr types.Address) (*big.Int, error) {
 	account, err := getAccountImpl(t.state, root, addr)
 
@@ -569,6 +626,8 @@ func (s *Server) setupConsensus() error {
 			SecretsManager:  s.secretsManager,
 			BlockTime:       uint64(blockTime.Seconds()),
 			MetricsInterval: s.config.MetricsInterval,
+			SyncMode:        s.config.SyncMode,
+			StateStorage:    s.stateStorage,
 			// event tracker
 			EventTracker: &consensus.EventTracker{
 				NumBlockConfirmations:  s.config.EventTracker.NumBlockConfirmations,
@@ -645,6 +704,29 @@ func (j *jsonRPCHub) GetAccount(root types.Hash, addr types.Address) (*jsonrpc.A
 }
 
 // GetForksInTime returns the active forks at the given block height
+func (j *jsonRPCHub) GetTxLifecycle(hash types.Hash) (*jsonrpc.TxLifecycle, bool) {
+	lifecycle, ok := j.TxPool.TxLifecycle(hash)
+	if !ok {
+		return nil, false
+	}
+
+	events := make([]jsonrpc.TxLifecycleEvent, len(lifecycle.Events))
+	for i, event := range lifecycle.Events {
+		events[i] = jsonrpc.TxLifecycleEvent{
+			Status:    event.Type.String(),
+			Reason:    event.Reason,
+			Timestamp: uint64(event.Time.UnixMilli()),
+		}
+	}
+
+	return &jsonrpc.TxLifecycle{
+		Events:    events,
+		Demotions: lifecycle.Demotions,
+		InPool:    lifecycle.InPool,
+		NonceGap:  lifecycle.NonceGap,
+	}, true
+}
+
 func (j *jsonRPCHub) GetForksInTime(blockNumber uint64) chain.ForksInTime {
 	return j.Executor.GetForksInTime(blockNumber)
 }
@@ -679,11 +761,81 @@ func (j *jsonRPCHub) GetCode(root types.Hash, addr types.Address) ([]byte, error
 	return code, nil
 }
 
+// GetProof returns the account and storage merkle proofs at the given state root
+func (j *jsonRPCHub) GetProof(
+	root types.Hash,
+	addr types.Address,
+	storageKeys []types.Hash,
+) (*jsonrpc.AccountProof, error) {
+	snap, err := j.state.NewSnapshotAt(root)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get snapshot for root '%s': %w", root, err)
+	}
+
+	prover, ok := snap.(state.SnapshotProver)
+	if !ok {
+		return nil, errors.New("state snapshot does not support merkle proofs")
+	}
+
+	account, err := snap.GetAccount(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	accountProof, err := prover.GetAccountProof(addr)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &jsonrpc.AccountProof{
+		Account: jsonrpc.Account{
+			Balance: big.NewInt(0),
+		},
+		CodeHash:     types.EmptyCodeHash,
+		StorageRoot:  types.EmptyRootHash,
+		Proof:        accountProof,
+		StorageProof: make([]jsonrpc.StorageProof, len(storageKeys)),
+	}
+
+	if account != nil {
+		res.Nonce = account.Nonce
+		res.Balance = new(big.Int).Set(account.Balance)
+		res.CodeHash = types.BytesToHash(account.CodeHash)
+		res.StorageRoot = account.Root
+	}
+
+	for i, key := range storageKeys {
+		proof, err := prover.GetStorageProof(res.StorageRoot, key)
+		if err != nil {
+			return nil, err
+		}
+
+		res.StorageProof[i] = jsonrpc.StorageProof{
+			Key:   key,
+			Value: snap.GetStorage(addr, res.StorageRoot, key).Bytes(),
+			Proof: proof,
+		}
+	}
+
+	return res, nil
+}
+
 func (j *jsonRPCHub) ApplyTxn(
 	header *types.Header,
 	txn *types.Transaction,
 	override types.StateOverride,
 	nonPayable bool,
+) (result *runtime.ExecutionResult, err error) {
+	return j.ApplyTxnWithTracer(header, txn, override, nonPayable, nil)
+}
+
+// ApplyTxnWithTracer applies a transaction object to the blockchain and traces it with the given tracer
+func (j *jsonRPCHub) ApplyTxnWithTracer(
+	header *types.Header,
+	txn *types.Transaction,
+	override types.StateOverride,
+	nonPayable bool,
+	tracer tracer.Tracer,
 ) (result *runtime.ExecutionResult, err error) {
 	blockCreator, err := j.GetConsensus().GetBlockCreator(header)
 	if err != nil {
@@ -703,6 +855,10 @@ func (j *jsonRPCHub) ApplyTxn(
 
 	transition.SetNonPayable(nonPayable)
 
+	if tracer != nil {
+		transition.SetTracer(tracer)
+	}
+
 	result, err = transition.Apply(txn)
 
 	return
@@ -871,6 +1027,7 @@ func (s *Server) setupJSONRPC() error {
 		WebSocketReadLimit:       s.config.JSONRPC.WebSocketReadLimit,
 		TLSCertFile:              s.config.TLSCertFile,
 		TLSKeyFile:               s.config.TLSKeyFile,
+		TxPoolAdmin:              s.config.JSONRPC.TxPoolAdmin,
 	}
 
 	srv, err := jsonrpc.NewJSONRPC(s.logger, conf)
@@ -931,6 +1088,18 @@ func (s *Server) Close() {
 		s.logger.Error("failed to close consensus", "err", err.Error())
 	}
 
+	// Stop pruning before closing the state storage
+	if s.statePruner != nil {
+		s.statePruner.close()
+	}
+
+	// Persist the flat snapshot, so that it is not regenerated on the next start
+	if st, ok := s.state.(*itrie.State); ok {
+		if err := st.FlushFlatSnapshot(s.blockchain.Header().StateRoot); err != nil {
+			s.logger.Error("failed to flush flat state snapshot", "err", err.Error())
+		}
+	}
+
 	// Close the state storage
 	if err := s.stateStorage.Close(); err != nil {
 		s.logger.Error("failed to close storage for trie", "err", err.Error())
@@ -0,0 +1,131 @@
+package server
+
+import (
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// MinStateRetention is the minimal number of recent block states retained when the state pruning is enabled
+const MinStateRetention uint64 = 128
+
+type statePrunerBlockchain interface {
+	SubscribeEvents() blockchain.Subscription
+	UnsubscribeEvents(blockchain.Subscription)
+	Header() *types.Header
+	GetHeaderByNumber(uint64) (*types.Header, bool)
+}
+
+// statePruner removes the state of the blocks which fall out of the retention window.
+// The state is pruned each time the chain grows by the number of retained blocks,
+// so at most twice the retention of block states are kept in the storage
+type statePruner struct {
+	logger     hclog.Logger
+	state      *itrie.State
+	blockchain statePrunerBlockchain
+
+	// retention is the number of the most recent block states to keep
+	retention uint64
+	// pinnedRoots are the state roots which are never pruned (e.g. genesis)
+	pinnedRoots []types.Hash
+	// lastPruned is the block number at which the state was pruned the last time
+	lastPruned uint64
+
+	closeCh chan struct{}
+	doneCh  chan struct{}
+}
+
+func newStatePruner(
+	logger hclog.Logger,
+	state *itrie.State,
+	blockchain statePrunerBlockchain,
+	retention uint64,
+	pinnedRoots ...types.Hash,
+) *statePruner {
+	state.EnablePruning()
+
+	return &statePruner{
+		logger:      logger.Named("state_pruner"),
+		state:       state,
+		blockchain:  blockchain,
+		retention:   retention,
+		pinnedRoots: pinnedRoots,
+		closeCh:     make(chan struct{}),
+		doneCh:      make(chan struct{}),
+	}
+}
+
+// start prunes the stale state and keeps pruning it as new blocks are written
+func (p *statePruner) start() {
+	sub := p.blockchain.SubscribeEvents()
+
+	go func() {
+		defer close(p.doneCh)
+		defer p.blockchain.UnsubscribeEvents(sub)
+
+		p.prune(p.blockchain.Header().Number)
+
+		eventCh := sub.GetEventCh()
+
+		for {
+			select {
+			case <-p.closeCh:
+				return
+			case ev, ok := <-eventCh:
+				if !ok {
+					return
+				}
+
+				if len(ev.NewChain) == 0 {
+					continue
+				}
+
+				if head := ev.Header().Number; head >= p.lastPruned+p.retention {
+					p.prune(head)
+				}
+			}
+		}
+	}()
+}
+
+// close stops the pruner and waits for the prune in progress to finish
+func (p *statePruner) close() {
+	close(p.closeCh)
+	<-p.doneCh
+}
+
+// prune removes the state of all the blocks older than the retention window ending at the given head
+func (p *statePruner) prune(head uint64) {
+	if head < p.retention {
+		return
+	}
+
+	roots := make([]types.Hash, 0, p.retention+uint64(len(p.pinnedRoots)))
+	roots = append(roots, p.pinnedRoots...)
+
+	for num := head - p.retention + 1; num <= head; num++ {
+		header, ok := p.blockchain.GetHeaderByNumber(num)
+		if !ok {
+			p.logger.Error("failed to prune state, header not found", "number", num)
+
+			return
+		}
+
+		roots = append(roots, header.StateRoot)
+	}
+
+	p.logger.Info("pruning state", "head", head, "retention", p.retention)
+
+	res, err := p.state.Prune(roots)
+	if err != nil {
+		p.logger.Error("failed to prune state", "err", err)
+
+		return
+	}
+
+	p.lastPruned = head
+
+	p.logger.Info("state pruned", "head", head, "retained", res.Retained, "pruned", res.Pruned)
+}
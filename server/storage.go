@@ -0,0 +1,148 @@
+package server
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/blockchain/storage"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/leveldb"
+	"github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
+)
+
+// DBBackend is the key-value database of the blockchain and state storages
+type DBBackend string
+
+const (
+	LevelDBBackend DBBackend = "leveldb"
+	PebbleBackend  DBBackend = "pebble"
+)
+
+// DBBackendFile is the file in the database directory which holds the name of the database backend
+const DBBackendFile = "DB_BACKEND"
+
+// ErrDBBackendMismatch is returned when the database is opened with a different backend than the one it's written with
+var ErrDBBackendMismatch = errors.New("database backend mismatch")
+
+// NewBlockchainStorage opens the blockchain storage in the given directory using the given database
+func NewBlockchainStorage(backend DBBackend, path string, logger hclog.Logger) (storage.Storage, error) {
+	backend, err := checkDBBackend(backend, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var db storage.Storage
+
+	switch backend {
+	case LevelDBBackend:
+		db, err = leveldb.NewLevelDBStorage(path, logger)
+	case PebbleBackend:
+		db, err = pebble.NewPebbleStorage(path, logger)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WriteDBBackend(path, backend); err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// NewStateStorage opens the state trie storage in the given directory using the given database
+func NewStateStorage(backend DBBackend, path string, logger hclog.Logger) (itrie.Storage, error) {
+	backend, err := checkDBBackend(backend, path)
+	if err != nil {
+		return nil, err
+	}
+
+	var db itrie.Storage
+
+	switch backend {
+	case LevelDBBackend:
+		db, err = itrie.NewLevelDBStorage(path, logger)
+	case PebbleBackend:
+		db, err = itrie.NewPebbleStorage(path, logger)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if err := WriteDBBackend(path, backend); err != nil {
+		_ = db.Close()
+
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// checkDBBackend returns the backend to open the database in the given directory with,
+// and fails if the existing database has been written with another backend
+func checkDBBackend(backend DBBackend, path string) (DBBackend, error) {
+	switch backend {
+	case LevelDBBackend, "":
+		backend = LevelDBBackend
+	case PebbleBackend:
+	default:
+		return "", fmt.Errorf("unknown database backend %s", backend)
+	}
+
+	existing, err := ReadDBBackend(path)
+	if err != nil {
+		return "", err
+	}
+
+	if existing != "" && existing != backend {
+		return "", fmt.Errorf("%w: %s is a %s database, but %s is configured (see the db migrate command)",
+			ErrDBBackendMismatch, path, existing, backend)
+	}
+
+	return backend, nil
+}
+
+// ReadDBBackend returns the backend of the database in the given directory, or an empty string
+// if there is no database. The backend of the databases which have not recorded it is detected
+// from their files, as only pebble writes the OPTIONS files
+func ReadDBBackend(path string) (DBBackend, error) {
+	data, err := os.ReadFile(filepath.Join(path, DBBackendFile))
+	if err == nil {
+		return DBBackend(strings.TrimSpace(string(data))), nil
+	}
+
+	if !errors.Is(err, os.ErrNotExist) {
+		return "", err
+	}
+
+	if _, err := os.Stat(filepath.Join(path, "CURRENT")); errors.Is(err, os.ErrNotExist) {
+		return "", nil
+	} else if err != nil {
+		return "", err
+	}
+
+	options, err := filepath.Glob(filepath.Join(path, "OPTIONS-*"))
+	if err != nil {
+		return "", err
+	}
+
+	if len(options) > 0 {
+		return PebbleBackend, nil
+	}
+
+	return LevelDBBackend, nil
+}
+
+// WriteDBBackend records the backend of the database in the given directory
+func WriteDBBackend(path string, backend DBBackend) error {
+	return os.WriteFile(filepath.Join(path, DBBackendFile), []byte(backend), 0600)
+}
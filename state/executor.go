@@ -270,6 +270,8 @@ type Transition struct {
 	journalRevisions []runtime.JournalRevision
 
 	accessList *runtime.AccessList
+
+	transientStorage *runtime.TransientStorage
 }
 
 func NewTransition(logger hclog.Logger, config chain.ForksInTime, snap Snapshot, radix *Txn) *Transition {
@@ -282,6 +284,8 @@ func NewTransition(logger hclog.Logger, config chain.ForksInTime, snap Snapshot,
 		precompiles: precompiled.NewPrecompiled(),
 		journal:     &runtime.Journal{},
 		accessList:  runtime.NewAccessList(),
+
+		transientStorage: runtime.NewTransientStorage(),
 	}
 }
 
@@ -384,6 +388,35 @@ func (t *Transition) Write(txn *types.Transaction) error {
 	return nil
 }
 
+// WriteBundle writes the transactions atomically. If any of them can not be applied or fails,
+// the transition is reverted to the state before the bundle
+func (t *Transition) WriteBundle(txs []*types.Transaction) error {
+	snapshot := t.Snapshot()
+	totalGas, gasPool, receipts := t.totalGas, t.gasPool, len(t.receipts)
+
+	revert := func(err error) error {
+		if revertErr := t.RevertToSnapshot(snapshot); revertErr != nil {
+			return revertErr
+		}
+
+		t.totalGas, t.gasPool, t.receipts = totalGas, gasPool, t.receipts[:receipts]
+
+		return err
+	}
+
+	for _, txn := range txs {
+		if err := t.Write(txn); err != nil {
+			return revert(err)
+		}
+
+		if receipt := t.receipts[len(t.receipts)-1]; *receipt.Status == types.ReceiptFailed {
+			return revert(fmt.Errorf("%w: %s", ErrBundleTxFailed, txn.Hash()))
+		}
+	}
+
+	return nil
+}
+
 // Commit commits the final result
 func (t *Transition) Commit() (Snapshot, types.Hash, error) {
 	objs, err := t.state.Commit(t.config.EIP155)
@@ -555,6 +588,9 @@ var (
 	ErrNotEnoughIntrinsicGas = errors.New("not enough gas supplied for intrinsic gas costs")
 	ErrInsufficientFunds     = errors.New("insufficient funds for gas * price + value")
 
+	// ErrBundleTxFailed is returned if a transaction of a bundle is executed, but it fails (e.g. reverts)
+	ErrBundleTxFailed = errors.New("bundle transaction failed")
+
 	// ErrTipAboveFeeCap is a sanity error to ensure no one is able to specify a
 	// transaction with a tip higher than the total fee cap.
 	ErrTipAboveFeeCap = errors.New("max priority fee per gas higher than max fee per gas")
@@ -604,6 +640,10 @@ func NewGasLimitReachedTransitionApplicationError(err error) *GasLimitReachedTra
 func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, error) {
 	var err error
 
+	if preStateTracer, ok := t.ctx.Tracer.(tracer.PreStateTracer); ok {
+		preStateTracer.CaptureTxPreState(msg, t.ctx.Coinbase, t)
+	}
+
 	if msg.Type() == types.StateTxType {
 		err = checkAndProcessStateTx(msg)
 	} else {
@@ -624,7 +664,7 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	}
 
 	// 4. there is no overflow when calculating intrinsic gas
-	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul)
+	intrinsicGasCost, err := TransactionGasCost(msg, t.config.Homestead, t.config.Istanbul, t.config.Shanghai)
 	if err != nil {
 		return nil, NewTransitionApplicationError(err, false)
 	}
@@ -651,11 +691,19 @@ func (t *Transition) apply(msg *types.Transaction) (*runtime.ExecutionResult, er
 	initialAccessList := runtime.NewAccessList()
 	if t.config.Berlin {
 		// populate access list in case Berlin fork is active
-		initialAccessList.PrepareAccessList(msg.From(), msg.To(), t.precompiles.Addrs, msg.AccessList())
+		initialAccessList.PrepareAccessList(msg.From(), msg.To(), t.precompiles.ActiveAddrs(&t.config), msg.AccessList())
+
+		// EIP-3651: the coinbase is warm at the start of the transaction
+		if t.config.Shanghai {
+			initialAccessList.AddAddress(t.ctx.Coinbase)
+		}
 	}
 
 	t.accessList = initialAccessList
 
+	// transient storage is discarded at the end of the transaction
+	t.transientStorage = runtime.NewTransientStorage()
+
 	var result *runtime.ExecutionResult
 	if msg.IsContractCreation() {
 		result = t.Create2(msg.From(), msg.Input(), value, gasLeft)
@@ -900,6 +948,9 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 	// Take snapshot of the current state
 	snapshot := t.Snapshot()
 
+	// the mark is reverted along with the failed creation
+	t.state.MarkCreated(c.Address)
+
 	if t.config.EIP158 {
 		// Force the creation of the account
 		t.state.CreateAccount(c.Address)
@@ -919,7 +970,7 @@ func (t *Transition) applyCreate(c *runtime.Contract, host runtime.Host) *runtim
 
 	var result *runtime.ExecutionResult
 
-	t.captureCallStart(c, evm.CREATE)
+	t.captureCallStart(c, runtime.Create)
 
 	defer func() {
 		// pass result to be set later
@@ -1118,6 +1169,17 @@ func (t *Transition) GetNonce(addr types.Address) uint64 {
 }
 
 func (t *Transition) Selfdestruct(addr types.Address, beneficiary types.Address) {
+	// EIP-6780: the account is only deleted if it was created in the same transaction,
+	// otherwise its balance is just transferred to the beneficiary
+	if t.config.EIP6780 && !t.state.IsCreated(addr) {
+		if addr != beneficiary {
+			t.state.AddBalance(beneficiary, t.state.GetBalance(addr))
+			t.state.SetBalance(addr, big.NewInt(0))
+		}
+
+		return
+	}
+
 	if !t.config.London && !t.state.HasSuicided(addr) {
 		t.state.AddRefund(24000)
 	}
@@ -1184,7 +1246,7 @@ func (t *Transition) GetRefund() uint64 {
 	return t.state.GetRefund()
 }
 
-func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (uint64, error) {
+func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul, isShanghai bool) (uint64, error) {
 	cost := uint64(0)
 
 	// Contract creation is only paid on the homestead fork
@@ -1195,6 +1257,16 @@ func TransactionGasCost(msg *types.Transaction, isHomestead, isIstanbul bool) (u
 	}
 
 	payload := msg.Input()
+
+	// EIP-3860: limit and meter the init code
+	if msg.IsContractCreation() && isShanghai {
+		if len(payload) > runtime.MaxInitCodeSize {
+			return 0, runtime.ErrMaxInitCodeSizeExceeded
+		}
+
+		cost += ((uint64(len(payload)) + 31) / 32) * runtime.InitCodeWordGas
+	}
+
 	if len(payload) > 0 {
 		zeros := uint64(0)
 
@@ -1297,6 +1369,12 @@ func (t *Transition) captureCallStart(c *runtime.Contract, callType runtime.Call
 		return
 	}
 
+	input := c.Input
+	if callType == runtime.Create {
+		// the input of a contract creation is its init code
+		input = c.Code
+	}
+
 	t.ctx.Tracer.CallStart(
 		c.Depth,
 		c.Caller,
@@ -1304,7 +1382,7 @@ func (t *Transition) captureCallStart(c *runtime.Contract, callType runtime.Call
 		int(callType),
 		c.Gas,
 		c.Value,
-		c.Input,
+		input,
 	)
 }
 
@@ -1357,7 +1435,7 @@ func (t *Transition) RevertToSnapshot(snapshot int) error {
 
 // PopulateAccessList populates access list based on the provided access list
 func (t *Transition) PopulateAccessList(from types.Address, to *types.Address, acl types.TxAccessList) {
-	t.accessList.PrepareAccessList(from, to, t.precompiles.Addrs, acl)
+	t.accessList.PrepareAccessList(from, to, t.precompiles.ActiveAddrs(&t.config), acl)
 }
 
 func (t *Transition) AddSlotToAccessList(addr types.Address, slot types.Hash) {
@@ -1385,3 +1463,21 @@ func (t *Transition) DeleteAccessListAddress(addr types.Address) {
 func (t *Transition) DeleteAccessListSlot(addr types.Address, slot types.Hash) {
 	t.accessList.DeleteSlot(addr, slot)
 }
+
+func (t *Transition) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	return t.transientStorage.Get(addr, key)
+}
+
+func (t *Transition) SetTransientState(addr types.Address, key types.Hash, value types.Hash) {
+	prev := t.transientStorage.Get(addr, key)
+	if prev == value {
+		return
+	}
+
+	t.journal.Append(&runtime.TransientStorageChange{Address: addr, Key: key, Prev: prev})
+	t.transientStorage.Set(addr, key, value)
+}
+
+func (t *Transition) RevertTransientState(addr types.Address, key types.Hash, prev types.Hash) {
+	t.transientStorage.Set(addr, key, prev)
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
 	"github.com/0xPolygon/polygon-edge/types"
@@ -270,3 +271,403 @@ func Test_Transition_EIP2929(t *testing.T) {
 		})
 	}
 }
+
+func Test_Transition_EIP3651(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender   = types.Address{0x1}
+		contract = types.Address{0x2}
+		coinbase = types.Address{0x3}
+	)
+
+	// balance(coinbase)
+	code := []byte{uint8(evm.COINBASE), uint8(evm.BALANCE), uint8(evm.POP), uint8(evm.STOP)}
+
+	tests := []struct {
+		name    string
+		forks   *chain.Forks
+		gasUsed uint64
+	}{
+		{
+			name:  "warm coinbase",
+			forks: chain.AllForksEnabled,
+			// WarmStorageReadCostEIP2929 charged for the BALANCE
+			gasUsed: 21104,
+		},
+		{
+			name:  "cold coinbase before Shanghai",
+			forks: chain.AllForksEnabled.Copy().RemoveFork(chain.Shanghai),
+			// ColdAccountAccessCostEIP2929 charged for the BALANCE
+			gasUsed: 23604,
+		},
+	}
+
+	for _, testCase := range tests {
+		tt := testCase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := newStateWithPreState(map[types.Address]*PreState{
+				sender: {Balance: 1_000_000},
+			})
+
+			txn := newTxn(state)
+			txn.SetCode(contract, code)
+
+			transition := NewTransition(hclog.NewNullLogger(), tt.forks.At(0), state, txn)
+			transition.gasPool = 1_000_000
+			transition.ctx.BaseFee = big.NewInt(0)
+			transition.ctx.Coinbase = coinbase
+
+			require.NoError(t, transition.Write(types.NewTx(types.NewLegacyTx(
+				types.WithFrom(sender),
+				types.WithTo(&contract),
+				types.WithGas(50_000),
+				types.WithGasPrice(big.NewInt(0)),
+			))))
+
+			require.Equal(t, tt.gasUsed, transition.Receipts()[0].GasUsed)
+		})
+	}
+}
+
+func Test_Transition_WarmPrecompiles(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender   = types.Address{0x1}
+		contract = types.Address{0x2}
+	)
+
+	tests := []struct {
+		name       string
+		precompile types.Address
+		forks      *chain.Forks
+		gasUsed    uint64
+	}{
+		{
+			name:       "secp256r1 verification warm after RIP-7212",
+			precompile: types.StringToAddress("0x100"),
+			forks:      chain.AllForksEnabled,
+			// WarmStorageReadCostEIP2929 charged for the BALANCE
+			gasUsed: 21105,
+		},
+		{
+			name:       "secp256r1 verification cold before RIP-7212",
+			precompile: types.StringToAddress("0x100"),
+			forks:      chain.AllForksEnabled.Copy().RemoveFork(chain.RIP7212),
+			// ColdAccountAccessCostEIP2929 charged for the BALANCE
+			gasUsed: 23605,
+		},
+		{
+			name:       "BLS12-381 pairing warm after EIP-2537",
+			precompile: types.StringToAddress("0x0f"),
+			forks:      chain.AllForksEnabled,
+			gasUsed:    21105,
+		},
+		{
+			name:       "BLS12-381 pairing cold before EIP-2537",
+			precompile: types.StringToAddress("0x0f"),
+			forks:      chain.AllForksEnabled.Copy().RemoveFork(chain.EIP2537),
+			gasUsed:    23605,
+		},
+	}
+
+	for _, testCase := range tests {
+		tt := testCase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			// balance(precompile), pushed by PUSH20
+			code := append(append([]byte{uint8(evm.PUSH1) + types.AddressLength - 1}, tt.precompile.Bytes()...),
+				uint8(evm.BALANCE), uint8(evm.POP), uint8(evm.STOP))
+
+			state := newStateWithPreState(map[types.Address]*PreState{
+				sender: {Balance: 1_000_000},
+			})
+
+			txn := newTxn(state)
+			txn.SetCode(contract, code)
+
+			transition := NewTransition(hclog.NewNullLogger(), tt.forks.At(0), state, txn)
+			transition.gasPool = 1_000_000
+			transition.ctx.BaseFee = big.NewInt(0)
+
+			require.NoError(t, transition.Write(types.NewTx(types.NewLegacyTx(
+				types.WithFrom(sender),
+				types.WithTo(&contract),
+				types.WithGas(50_000),
+				types.WithGasPrice(big.NewInt(0)),
+			))))
+
+			require.Equal(t, tt.gasUsed, transition.Receipts()[0].GasUsed)
+		})
+	}
+}
+
+func TestTransition_WriteBundle(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender    = types.Address{0x1}
+		receiver  = types.Address{0x2}
+		reverting = types.Address{0x3}
+	)
+
+	newTransition := func(t *testing.T) *Transition {
+		t.Helper()
+
+		state := newStateWithPreState(map[types.Address]*PreState{
+			sender: {Balance: 1_000_000},
+		})
+
+		txn := newTxn(state)
+		// PUSH1 0x0 PUSH1 0x0 REVERT
+		txn.SetCode(reverting, []byte{byte(evm.PUSH1), 0x0, byte(evm.PUSH1), 0x0, byte(evm.REVERT)})
+
+		transition := NewTransition(hclog.NewNullLogger(), chain.AllForksEnabled.At(0), state, txn)
+		transition.gasPool = 1_000_000
+		transition.ctx.BaseFee = big.NewInt(0)
+
+		return transition
+	}
+
+	newTransfer := func(nonce uint64, to types.Address) *types.Transaction {
+		return types.NewTx(types.NewLegacyTx(
+			types.WithNonce(nonce),
+			types.WithFrom(sender),
+			types.WithTo(&to),
+			types.WithValue(big.NewInt(100)),
+			types.WithGas(50_000),
+			types.WithGasPrice(big.NewInt(0)),
+		))
+	}
+
+	t.Run("all transactions succeed", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(t)
+
+		require.NoError(t, transition.WriteBundle([]*types.Transaction{
+			newTransfer(0, receiver),
+			newTransfer(1, receiver),
+		}))
+
+		require.Len(t, transition.Receipts(), 2)
+		require.Equal(t, big.NewInt(200), transition.GetBalance(receiver))
+		require.Equal(t, uint64(2), transition.GetNonce(sender))
+	})
+
+	for _, bundleCase := range []struct {
+		name string
+		last *types.Transaction
+		err  error
+	}{
+		{"failed transaction", newTransfer(2, reverting), ErrBundleTxFailed},
+		{"invalid transaction", newTransfer(5, receiver), ErrNonceTooHigh},
+	} {
+		bundleCase := bundleCase
+
+		t.Run(bundleCase.name+" reverts the bundle", func(t *testing.T) {
+			t.Parallel()
+
+			transition := newTransition(t)
+
+			require.NoError(t, transition.Write(newTransfer(0, receiver)))
+
+			gasPool, totalGas := transition.gasPool, transition.TotalGas()
+
+			err := transition.WriteBundle([]*types.Transaction{newTransfer(1, receiver), bundleCase.last})
+			require.ErrorContains(t, err, bundleCase.err.Error())
+
+			require.Len(t, transition.Receipts(), 1)
+			require.Equal(t, totalGas, transition.TotalGas())
+			require.Equal(t, gasPool, transition.gasPool)
+			require.Equal(t, big.NewInt(100), transition.GetBalance(receiver))
+			require.Equal(t, uint64(1), transition.GetNonce(sender))
+		})
+	}
+}
+
+// Tests for EIP-1153
+func Test_Transition_TransientStorage(t *testing.T) {
+	t.Parallel()
+
+	var (
+		contract = types.BytesToAddress([]byte("contract"))
+		slot     = types.BytesToHash([]byte{0x1})
+		value    = types.BytesToHash([]byte{0x2})
+	)
+
+	// tstore(0x1, 0x2)
+	tstore := []byte{uint8(evm.PUSH1), 0x2, uint8(evm.PUSH1), 0x1, uint8(evm.TSTORE)}
+
+	tests := []struct {
+		name     string
+		code     []byte
+		forks    *chain.Forks
+		expected types.Hash
+		failed   bool
+	}{
+		{
+			name:     "kept until the end of the transaction",
+			code:     append(append([]byte{}, tstore...), uint8(evm.STOP)),
+			forks:    chain.AllForksEnabled,
+			expected: value,
+		},
+		{
+			name: "reverted along with the call",
+			code: append(append([]byte{}, tstore...),
+				uint8(evm.PUSH1), 0x0, uint8(evm.PUSH1), 0x0, uint8(evm.REVERT)),
+			forks:    chain.AllForksEnabled,
+			expected: types.ZeroHash,
+			failed:   true,
+		},
+		{
+			name:     "not available before Cancun",
+			code:     append(append([]byte{}, tstore...), uint8(evm.STOP)),
+			forks:    chain.AllForksEnabled.Copy().RemoveFork(chain.Cancun),
+			expected: types.ZeroHash,
+			failed:   true,
+		},
+	}
+
+	for _, testCase := range tests {
+		tt := testCase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := newStateWithPreState(nil)
+			txn := newTxn(state)
+			txn.SetCode(contract, tt.code)
+
+			transition := NewTransition(hclog.NewNullLogger(), tt.forks.At(0), state, txn)
+
+			result := transition.Call2(transition.ctx.Origin, contract, nil, big.NewInt(0), uint64(100000))
+			require.Equal(t, tt.failed, result.Failed())
+			require.Equal(t, tt.expected, transition.GetTransientState(contract, slot))
+		})
+	}
+}
+
+// Tests for EIP-6780
+func Test_Transition_EIP6780(t *testing.T) {
+	t.Parallel()
+
+	var (
+		sender      = types.BytesToAddress([]byte("sender"))
+		contract    = types.BytesToAddress([]byte("contract"))
+		beneficiary = types.BytesToAddress([]byte("beneficiary"))
+	)
+
+	// PUSH20 beneficiary SELFDESTRUCT
+	selfdestruct := append(append([]byte{uint8(evm.PUSH1) + 19}, beneficiary.Bytes()...), uint8(evm.SELFDESTRUCT))
+
+	newTransition := func(t *testing.T, forks *chain.Forks) *Transition {
+		t.Helper()
+
+		state := newStateWithPreState(map[types.Address]*PreState{
+			sender:   {Balance: 1000},
+			contract: {Nonce: 1, Balance: 100},
+		})
+
+		txn := newTxn(state)
+		txn.SetCode(contract, selfdestruct)
+
+		return NewTransition(hclog.NewNullLogger(), forks.At(0), state, txn)
+	}
+
+	t.Run("existing contract only transfers its balance", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(t, chain.AllForksEnabled)
+
+		result := transition.Call2(sender, contract, nil, big.NewInt(0), uint64(100000))
+		require.NoError(t, result.Err)
+
+		require.False(t, transition.state.HasSuicided(contract))
+		require.Equal(t, selfdestruct, transition.GetCode(contract))
+		require.Equal(t, uint64(1), transition.GetNonce(contract))
+		require.Zero(t, transition.GetBalance(contract).Sign())
+		require.Equal(t, big.NewInt(100), transition.GetBalance(beneficiary))
+	})
+
+	t.Run("contract created in the same transaction is deleted", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(t, chain.AllForksEnabled)
+		created := crypto.CreateAddress(sender, transition.GetNonce(sender))
+
+		// the init code destructs the contract being created
+		result := transition.Create2(sender, selfdestruct, big.NewInt(10), uint64(100000))
+		require.NoError(t, result.Err)
+
+		require.True(t, transition.state.HasSuicided(created))
+		require.Equal(t, big.NewInt(10), transition.GetBalance(beneficiary))
+	})
+
+	t.Run("existing contract is deleted before EIP-6780", func(t *testing.T) {
+		t.Parallel()
+
+		transition := newTransition(t, chain.AllForksEnabled.Copy().RemoveFork(chain.EIP6780))
+
+		result := transition.Call2(sender, contract, nil, big.NewInt(0), uint64(100000))
+		require.NoError(t, result.Err)
+
+		require.True(t, transition.state.HasSuicided(contract))
+		require.Equal(t, big.NewInt(100), transition.GetBalance(beneficiary))
+	})
+}
+
+// Tests for EIP-3860
+func TestTransactionGasCost_InitCode(t *testing.T) {
+	t.Parallel()
+
+	newCreation := func(size int) *types.Transaction {
+		return types.NewTx(types.NewLegacyTx(types.WithInput(make([]byte, size))))
+	}
+
+	tests := []struct {
+		name       string
+		tx         *types.Transaction
+		isShanghai bool
+		cost       uint64
+		err        error
+	}{
+		{
+			name: "init code is not metered before Shanghai",
+			tx:   newCreation(33),
+			cost: TxGasContractCreation + 33*4,
+		},
+		{
+			name:       "init code words are metered",
+			tx:         newCreation(33),
+			isShanghai: true,
+			cost:       TxGasContractCreation + 33*4 + 2*runtime.InitCodeWordGas,
+		},
+		{
+			name:       "init code of the max size",
+			tx:         newCreation(runtime.MaxInitCodeSize),
+			isShanghai: true,
+			cost:       TxGasContractCreation + runtime.MaxInitCodeSize*4 + runtime.MaxInitCodeSize/32*runtime.InitCodeWordGas,
+		},
+		{
+			name:       "init code exceeding the max size",
+			tx:         newCreation(runtime.MaxInitCodeSize + 1),
+			isShanghai: true,
+			err:        runtime.ErrMaxInitCodeSizeExceeded,
+		},
+	}
+
+	for _, testCase := range tests {
+		tt := testCase
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cost, err := TransactionGasCost(tt.tx, true, true, tt.isShanghai)
+			require.ErrorIs(t, err, tt.err)
+			require.Equal(t, tt.cost, cost)
+		})
+	}
+}
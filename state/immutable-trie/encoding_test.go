@@ -173,3 +173,11 @@ func TestEncoding_HexCompact(t *testing.T) {
 		})
 	}
 }
+
+func TestEncoding_HexNibblesToBytes(t *testing.T) {
+	t.Parallel()
+
+	key := []byte{0x12, 0xab, 0xff}
+
+	assert.Equal(t, key, hexNibblesToBytes(bytesToHexNibbles(key)))
+}
@@ -0,0 +1,590 @@
+package itrie
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/hashicorp/go-hclog"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// defaultMaxDiffLayers is the number of diff layers kept in memory on top of the disk layer
+const defaultMaxDiffLayers = 128
+
+// flatBatchSize is the number of entries after which the batch is written to the storage during generation
+const flatBatchSize = 10_000
+
+var (
+	// flatAccountPrefix is the prefix of the flat accounts keyed by the hash of the address
+	flatAccountPrefix = []byte("fa")
+	// flatStoragePrefix is the prefix of the flat storage slots keyed by the hash of the address and of the slot
+	flatStoragePrefix = []byte("fs")
+	// flatRootKey is the key of the state root of the flat disk layer
+	flatRootKey = []byte("flat-root")
+
+	// ErrFlatSnapshotNotSupported is returned when the trie storage can not hold the flat snapshot
+	ErrFlatSnapshotNotSupported = errors.New("trie storage does not support flat snapshot")
+
+	// errFlatLayerStale is returned when a layer has been flattened into the disk layer or dropped
+	errFlatLayerStale = errors.New("flat snapshot layer is stale")
+
+	// errFlatGenerationStopped is returned when the generation of the disk layer has been stopped
+	errFlatGenerationStopped = errors.New("flat snapshot generation stopped")
+)
+
+func flatAccountKey(hash types.Hash) []byte {
+	return append(append(make([]byte, 0, len(flatAccountPrefix)+types.HashLength), flatAccountPrefix...), hash.Bytes()...)
+}
+
+func flatStorageKey(accountHash, slotHash types.Hash) []byte {
+	return append(flatStorageAccountPrefix(accountHash), slotHash.Bytes()...)
+}
+
+func flatStorageAccountPrefix(accountHash types.Hash) []byte {
+	return append(
+		append(make([]byte, 0, len(flatStoragePrefix)+2*types.HashLength), flatStoragePrefix...),
+		accountHash.Bytes()...,
+	)
+}
+
+// flatLayer is a layer of the flat snapshot, which holds the accounts and the storage slots
+// of the state with the given root keyed by their hashes. The values are encoded the same way
+// as the leaves of the trie and nil values stand for the missing entries
+type flatLayer interface {
+	getAccount(hash types.Hash) ([]byte, error)
+	getStorage(accountHash, slotHash types.Hash) ([]byte, error)
+}
+
+// flatDiskLayer is the bottom layer of the flat snapshot persisted in the storage
+type flatDiskLayer struct {
+	storage   PrunableStorage
+	stateRoot types.Hash
+	stale     bool
+}
+
+func (d *flatDiskLayer) getAccount(hash types.Hash) ([]byte, error) {
+	return d.get(flatAccountKey(hash))
+}
+
+func (d *flatDiskLayer) getStorage(accountHash, slotHash types.Hash) ([]byte, error) {
+	return d.get(flatStorageKey(accountHash, slotHash))
+}
+
+func (d *flatDiskLayer) get(key []byte) ([]byte, error) {
+	if d.stale {
+		return nil, errFlatLayerStale
+	}
+
+	data, ok, err := d.storage.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	if !ok || len(data) == 0 {
+		return nil, nil
+	}
+
+	return data, nil
+}
+
+// flatDiffLayer holds the changes made by a single commit on top of its parent layer
+type flatDiffLayer struct {
+	parent    flatLayer
+	stateRoot types.Hash
+	stale     bool
+
+	// accounts are the changed accounts, a nil value marks a deleted account
+	accounts map[types.Hash][]byte
+	// destructs are the accounts whose storage is wiped before the storage changes are applied
+	destructs map[types.Hash]struct{}
+	// storages are the changed storage slots, a nil value marks a deleted slot
+	storages map[types.Hash]map[types.Hash][]byte
+}
+
+func (d *flatDiffLayer) getAccount(hash types.Hash) ([]byte, error) {
+	if d.stale {
+		return nil, errFlatLayerStale
+	}
+
+	if data, ok := d.accounts[hash]; ok {
+		return data, nil
+	}
+
+	return d.parent.getAccount(hash)
+}
+
+func (d *flatDiffLayer) getStorage(accountHash, slotHash types.Hash) ([]byte, error) {
+	if d.stale {
+		return nil, errFlatLayerStale
+	}
+
+	if slots, ok := d.storages[accountHash]; ok {
+		if data, ok := slots[slotHash]; ok {
+			return data, nil
+		}
+	}
+
+	if _, ok := d.destructs[accountHash]; ok {
+		return nil, nil
+	}
+
+	return d.parent.getStorage(accountHash, slotHash)
+}
+
+func newFlatDiffLayer() *flatDiffLayer {
+	return &flatDiffLayer{
+		accounts:  make(map[types.Hash][]byte),
+		destructs: make(map[types.Hash]struct{}),
+		storages:  make(map[types.Hash]map[types.Hash][]byte),
+	}
+}
+
+// setStorage records the change of the storage slot, it is a no-op on a nil layer
+func (d *flatDiffLayer) setStorage(accountKey, slotKey, data []byte) {
+	if d == nil {
+		return
+	}
+
+	accountHash := types.BytesToHash(accountKey)
+
+	slots, ok := d.storages[accountHash]
+	if !ok {
+		slots = make(map[types.Hash][]byte)
+		d.storages[accountHash] = slots
+	}
+
+	slots[types.BytesToHash(slotKey)] = data
+}
+
+// flatSnapshot is a tree of flat layers, which allows reading the accounts and the storage slots
+// of the recent states without walking the tries. The disk layer is persisted in the storage,
+// while the diff layers of the most recent commits are kept in memory on top of it
+type flatSnapshot struct {
+	storage       PrunableStorage
+	maxDiffLayers int
+	logger        hclog.Logger
+
+	lock   sync.RWMutex
+	layers map[types.Hash]flatLayer
+	// generated is false while the disk layer is being generated,
+	// the reads are served by the trie until then
+	generated bool
+
+	// stopCh stops the generation in progress, doneCh is closed once the generation has finished
+	stopCh   chan struct{}
+	stopOnce sync.Once
+	doneCh   chan struct{}
+}
+
+// newFlatSnapshot loads the flat snapshot from the storage, or starts generating it
+// from the trie in the background if the persisted disk layer does not match the given root
+func newFlatSnapshot(storage PrunableStorage, root types.Hash, logger hclog.Logger) (*flatSnapshot, error) {
+	f := &flatSnapshot{
+		storage:       storage,
+		maxDiffLayers: defaultMaxDiffLayers,
+		logger:        logger,
+		layers:        make(map[types.Hash]flatLayer),
+		stopCh:        make(chan struct{}),
+		doneCh:        make(chan struct{}),
+	}
+
+	data, ok, err := storage.Get(flatRootKey)
+	if err != nil {
+		return nil, err
+	}
+
+	f.layers[root] = &flatDiskLayer{storage: storage, stateRoot: root}
+
+	if ok && types.BytesToHash(data) == root {
+		f.generated = true
+		close(f.doneCh)
+
+		return f, nil
+	}
+
+	go f.generateInBackground(root)
+
+	return f, nil
+}
+
+// generateInBackground generates the disk layer with the given root. The diff layers committed
+// in the meantime are kept in memory and flattened once the generation is done.
+// The flat snapshot is disabled if the generation fails (e.g. the state has been pruned meanwhile)
+func (f *flatSnapshot) generateInBackground(root types.Hash) {
+	defer close(f.doneCh)
+
+	f.logger.Info("generating flat state snapshot", "root", root)
+
+	err := f.generate(root)
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if err != nil {
+		f.layers = make(map[types.Hash]flatLayer)
+
+		if errors.Is(err, errFlatGenerationStopped) {
+			f.logger.Info("flat state snapshot generation stopped")
+		} else {
+			f.logger.Error("failed to generate flat state snapshot", "err", err)
+		}
+
+		return
+	}
+
+	f.generated = true
+
+	f.logger.Info("flat state snapshot generated", "root", root)
+}
+
+// stop stops the generation in progress, if any, and waits for it to finish
+func (f *flatSnapshot) stop() {
+	f.stopOnce.Do(func() {
+		close(f.stopCh)
+	})
+
+	<-f.doneCh
+}
+
+// has returns true if the flat snapshot holds the state with the given root
+func (f *flatSnapshot) has(root types.Hash) bool {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	_, ok := f.layers[root]
+
+	return ok
+}
+
+// getAccount returns the account with the given hash in the state with the given root.
+// The second return value is false if the flat snapshot does not hold the state
+func (f *flatSnapshot) getAccount(root, hash types.Hash) ([]byte, bool) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	layer, ok := f.layers[root]
+	if !ok || !f.generated {
+		return nil, false
+	}
+
+	data, err := layer.getAccount(hash)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// getStorage returns the storage slot of the account in the state with the given root.
+// The second return value is false if the flat snapshot does not hold the state
+func (f *flatSnapshot) getStorage(root, accountHash, slotHash types.Hash) ([]byte, bool) {
+	f.lock.RLock()
+	defer f.lock.RUnlock()
+
+	layer, ok := f.layers[root]
+	if !ok || !f.generated {
+		return nil, false
+	}
+
+	data, err := layer.getStorage(accountHash, slotHash)
+	if err != nil {
+		return nil, false
+	}
+
+	return data, true
+}
+
+// update adds the changes committed on top of the parent state as a new diff layer
+// and flattens the oldest diff layers into the disk layer if there are too many of them
+func (f *flatSnapshot) update(parentRoot, root types.Hash, layer *flatDiffLayer) error {
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.layers[root]; ok {
+		return nil
+	}
+
+	parent, ok := f.layers[parentRoot]
+	if !ok {
+		// the parent state is not covered by the flat snapshot
+		return nil
+	}
+
+	layer.parent = parent
+	layer.stateRoot = root
+	f.layers[root] = layer
+
+	// the diff layers can't be flattened into the disk layer being generated
+	if !f.generated {
+		return nil
+	}
+
+	return f.flatten(root, f.maxDiffLayers)
+}
+
+// flush stops the generation in progress and flattens all the diff layers
+// below the given root into the disk layer. A stopped generation is restarted
+// the next time the flat snapshot is loaded
+func (f *flatSnapshot) flush(root types.Hash) error {
+	f.stop()
+
+	f.lock.Lock()
+	defer f.lock.Unlock()
+
+	if _, ok := f.layers[root]; !ok || !f.generated {
+		return nil
+	}
+
+	return f.flatten(root, 0)
+}
+
+// flatten merges the diff layers below the given root into the disk layer,
+// until at most the given number of diff layers remain. The layers which
+// do not descend from the new disk layer are dropped
+func (f *flatSnapshot) flatten(root types.Hash, keep int) error {
+	var diffs []*flatDiffLayer
+
+	for layer := f.layers[root]; ; {
+		diff, ok := layer.(*flatDiffLayer)
+		if !ok {
+			break
+		}
+
+		diffs = append(diffs, diff)
+		layer = diff.parent
+	}
+
+	if len(diffs) <= keep {
+		return nil
+	}
+
+	// the diff layers are ordered from the top one to the bottom one
+	for len(diffs) > keep {
+		bottom := diffs[len(diffs)-1]
+		diffs = diffs[:len(diffs)-1]
+
+		disk, ok := bottom.parent.(*flatDiskLayer)
+		if !ok {
+			return fmt.Errorf("parent of the bottom diff layer %s is not the disk layer", bottom.stateRoot)
+		}
+
+		newDisk, err := f.persist(disk, bottom)
+		if err != nil {
+			return err
+		}
+
+		disk.stale = true
+		bottom.stale = true
+
+		if len(diffs) > 0 {
+			diffs[len(diffs)-1].parent = newDisk
+		}
+
+		f.layers[newDisk.stateRoot] = newDisk
+	}
+
+	// drop the layers of the abandoned forks
+	for root, layer := range f.layers {
+		if !f.isLive(layer) {
+			delete(f.layers, root)
+		}
+	}
+
+	return nil
+}
+
+// isLive returns true if the layer descends from a non-stale disk layer
+func (f *flatSnapshot) isLive(layer flatLayer) bool {
+	for {
+		switch l := layer.(type) {
+		case *flatDiskLayer:
+			return !l.stale
+		case *flatDiffLayer:
+			if l.stale {
+				return false
+			}
+
+			layer = l.parent
+		default:
+			return false
+		}
+	}
+}
+
+// persist writes the changes of the diff layer to the storage and returns the new disk layer
+func (f *flatSnapshot) persist(disk *flatDiskLayer, diff *flatDiffLayer) (*flatDiskLayer, error) {
+	batch := f.storage.Batch()
+
+	for accountHash := range diff.destructs {
+		err := f.storage.Iterate(flatStorageAccountPrefix(accountHash), func(k []byte) bool {
+			batch.Delete(append([]byte{}, k...))
+
+			return true
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	for accountHash, slots := range diff.storages {
+		for slotHash, data := range slots {
+			if data == nil {
+				batch.Delete(flatStorageKey(accountHash, slotHash))
+			} else {
+				batch.Put(flatStorageKey(accountHash, slotHash), data)
+			}
+		}
+	}
+
+	for accountHash, data := range diff.accounts {
+		if data == nil {
+			batch.Delete(flatAccountKey(accountHash))
+		} else {
+			batch.Put(flatAccountKey(accountHash), data)
+		}
+	}
+
+	batch.Put(flatRootKey, diff.stateRoot.Bytes())
+
+	if err := batch.Write(); err != nil {
+		return nil, err
+	}
+
+	return &flatDiskLayer{storage: disk.storage, stateRoot: diff.stateRoot}, nil
+}
+
+// generate rebuilds the flat disk layer from the trie with the given root
+func (f *flatSnapshot) generate(root types.Hash) error {
+	if err := f.storage.Delete(flatRootKey); err != nil {
+		return err
+	}
+
+	batch := f.storage.Batch()
+	size := 0
+
+	write := func() error {
+		select {
+		case <-f.stopCh:
+			return errFlatGenerationStopped
+		default:
+		}
+
+		if size < flatBatchSize {
+			return nil
+		}
+
+		if err := batch.Write(); err != nil {
+			return err
+		}
+
+		batch = f.storage.Batch()
+		size = 0
+
+		return nil
+	}
+
+	// wipe the entries of the previous disk layer
+	for _, prefix := range [][]byte{flatAccountPrefix, flatStoragePrefix} {
+		var err error
+
+		iterErr := f.storage.Iterate(prefix, func(k []byte) bool {
+			batch.Delete(append([]byte{}, k...))
+			size++
+
+			err = write()
+
+			return err == nil
+		})
+		if iterErr != nil {
+			return iterErr
+		}
+
+		if err != nil {
+			return err
+		}
+	}
+
+	err := walkLeaves(f.storage, root, func(key, value []byte) error {
+		accountHash := types.BytesToHash(key)
+
+		batch.Put(flatAccountKey(accountHash), value)
+		size++
+
+		if err := write(); err != nil {
+			return err
+		}
+
+		var account state.Account
+		if err := account.UnmarshalRlp(value); err != nil {
+			return err
+		}
+
+		return walkLeaves(f.storage, account.Root, func(key, value []byte) error {
+			batch.Put(flatStorageKey(accountHash, types.BytesToHash(key)), value)
+			size++
+
+			return write()
+		})
+	})
+	if err != nil {
+		return err
+	}
+
+	batch.Put(flatRootKey, root.Bytes())
+
+	return batch.Write()
+}
+
+// walkLeaves calls the handler with the key and the value of each leaf of the trie with the given root
+func walkLeaves(storage Storage, root types.Hash, handler func(key, value []byte) error) error {
+	if root == types.EmptyRootHash || root == types.ZeroHash {
+		return nil
+	}
+
+	return walkHash(storage, root.Bytes(), nil, handler)
+}
+
+func walkHash(storage Storage, hash []byte, path []byte, handler func(key, value []byte) error) error {
+	node, ok, err := GetNode(hash, storage)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("trie node %s not found", types.BytesToHash(hash))
+	}
+
+	return walkNode(storage, node, path, handler)
+}
+
+func walkNode(storage Storage, node Node, path []byte, handler func(key, value []byte) error) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *ValueNode:
+		if n.hash {
+			return walkHash(storage, n.buf, path, handler)
+		}
+
+		return handler(hexNibblesToBytes(path), n.buf)
+
+	case *ShortNode:
+		return walkNode(storage, n.child, concat(path, n.key), handler)
+
+	case *FullNode:
+		for i, child := range n.children {
+			if err := walkNode(storage, child, concat(path, []byte{byte(i)}), handler); err != nil {
+				return err
+			}
+		}
+
+		return walkNode(storage, n.value, path, handler)
+
+	default:
+		return fmt.Errorf("unknown node type %T", node)
+	}
+}
@@ -0,0 +1,309 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	flatAddr1 = types.StringToAddress("0x1")
+	flatAddr2 = types.StringToAddress("0x2")
+	flatSlot1 = types.StringToHash("0x1")
+	flatSlot2 = types.StringToHash("0x2")
+)
+
+// flatTestBlocks are the changes of the committed blocks, which cover the updates,
+// the deletions and the recreation of an account with a wiped storage
+func flatTestBlocks(t *testing.T, s *State) [][]*state.Object {
+	t.Helper()
+
+	// the storage root of the second account after the first block
+	snap, _, err := s.NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  flatAddr2,
+			Balance:  big.NewInt(1),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+			Storage:  []*state.StorageObject{{Key: flatSlot1.Bytes(), Val: []byte{0x1}}},
+		},
+	})
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(flatAddr2)
+	require.NoError(t, err)
+
+	return [][]*state.Object{
+		{
+			{
+				Address:  flatAddr1,
+				Balance:  big.NewInt(10),
+				CodeHash: types.EmptyCodeHash,
+				Root:     types.EmptyRootHash,
+				Storage: []*state.StorageObject{
+					{Key: flatSlot1.Bytes(), Val: []byte{0x1}},
+					{Key: flatSlot2.Bytes(), Val: []byte{0x2}},
+				},
+			},
+			{
+				Address:  flatAddr2,
+				Balance:  big.NewInt(1),
+				CodeHash: types.EmptyCodeHash,
+				Root:     types.EmptyRootHash,
+				Storage:  []*state.StorageObject{{Key: flatSlot1.Bytes(), Val: []byte{0x1}}},
+			},
+		},
+		{
+			{
+				Address:  flatAddr1,
+				Balance:  big.NewInt(20),
+				Nonce:    1,
+				CodeHash: types.EmptyCodeHash,
+				Root:     types.EmptyRootHash, // recreated account
+				Storage:  []*state.StorageObject{{Key: flatSlot2.Bytes(), Val: []byte{0x3}}},
+			},
+			{
+				Address:  flatAddr2,
+				Balance:  big.NewInt(2),
+				CodeHash: types.EmptyCodeHash,
+				Root:     account.Root,
+				Storage:  []*state.StorageObject{{Key: flatSlot1.Bytes(), Deleted: true}},
+			},
+		},
+		{
+			{
+				Address: flatAddr2,
+				Deleted: true,
+			},
+		},
+	}
+}
+
+// requireFlatMatchesTrie checks that the reads served by the flat snapshot match the ones served by the trie
+func requireFlatMatchesTrie(t *testing.T, s *State, root types.Hash) {
+	t.Helper()
+
+	require.True(t, s.flat.has(root))
+
+	trieState := NewState(s.storage)
+
+	flatSnap, err := s.NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	trieSnap, err := trieState.NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	for _, addr := range []types.Address{flatAddr1, flatAddr2} {
+		expected, err := trieSnap.GetAccount(addr)
+		require.NoError(t, err)
+
+		actual, err := flatSnap.GetAccount(addr)
+		require.NoError(t, err)
+		require.Equal(t, expected, actual)
+
+		if expected == nil {
+			continue
+		}
+
+		for _, slot := range []types.Hash{flatSlot1, flatSlot2} {
+			require.Equal(t,
+				trieSnap.GetStorage(addr, expected.Root, slot),
+				flatSnap.GetStorage(addr, expected.Root, slot),
+			)
+		}
+	}
+}
+
+func TestFlatSnapshot_DiffLayers(t *testing.T) {
+	t.Parallel()
+
+	s := NewState(NewMemoryStorage())
+	require.NoError(t, s.EnableFlatSnapshot(types.EmptyRootHash, hclog.NewNullLogger()))
+	<-s.flat.doneCh
+
+	// flatten the oldest layers into the disk layer
+	s.flat.maxDiffLayers = 1
+
+	snap := s.NewSnapshot()
+	roots := make([]types.Hash, 0)
+
+	for _, objs := range flatTestBlocks(t, s) {
+		newSnap, root, err := snap.Commit(objs)
+		require.NoError(t, err)
+
+		roots = append(roots, types.BytesToHash(root))
+		requireFlatMatchesTrie(t, s, types.BytesToHash(root))
+
+		snap = newSnap
+	}
+
+	// the flattened states are not held by the flat snapshot anymore
+	require.False(t, s.flat.has(roots[0]))
+	require.True(t, s.flat.has(roots[1]))
+
+	account, err := snap.GetAccount(flatAddr1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(20), account.Balance)
+	require.Equal(t, types.Hash{}, snap.GetStorage(flatAddr1, account.Root, flatSlot1))
+	require.Equal(t, types.BytesToHash([]byte{0x3}), snap.GetStorage(flatAddr1, account.Root, flatSlot2))
+
+	account, err = snap.GetAccount(flatAddr2)
+	require.NoError(t, err)
+	require.Nil(t, account)
+}
+
+func TestFlatSnapshot_DropsForks(t *testing.T) {
+	t.Parallel()
+
+	s := NewState(NewMemoryStorage())
+	require.NoError(t, s.EnableFlatSnapshot(types.EmptyRootHash, hclog.NewNullLogger()))
+	<-s.flat.doneCh
+
+	s.flat.maxDiffLayers = 1
+
+	blocks := flatTestBlocks(t, s)
+
+	// the fork on top of the genesis is abandoned once the canonical chain gets flattened
+	_, forkRoot, err := s.NewSnapshot().Commit([]*state.Object{
+		{
+			Address:  flatAddr1,
+			Balance:  big.NewInt(99),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		},
+	})
+	require.NoError(t, err)
+	require.True(t, s.flat.has(types.BytesToHash(forkRoot)))
+
+	snap := s.NewSnapshot()
+
+	for _, objs := range blocks {
+		snap, _, err = snap.Commit(objs)
+		require.NoError(t, err)
+	}
+
+	require.False(t, s.flat.has(types.BytesToHash(forkRoot)))
+
+	// the fork state is still readable from the trie
+	forkSnap, err := s.NewSnapshotAt(types.BytesToHash(forkRoot))
+	require.NoError(t, err)
+
+	account, err := forkSnap.GetAccount(flatAddr1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(99), account.Balance)
+}
+
+func TestFlatSnapshot_GenerateAndFlush(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+
+	// commit the blocks without the flat snapshot
+	snap := NewState(storage).NewSnapshot()
+
+	var root []byte
+
+	for _, objs := range flatTestBlocks(t, NewState(storage))[:2] {
+		var err error
+
+		snap, root, err = snap.Commit(objs)
+		require.NoError(t, err)
+	}
+
+	// the flat snapshot is generated from the trie
+	s := NewState(storage)
+	require.NoError(t, s.EnableFlatSnapshot(types.BytesToHash(root), hclog.NewNullLogger()))
+	<-s.flat.doneCh
+	requireFlatMatchesTrie(t, s, types.BytesToHash(root))
+
+	newSnap, err := s.NewSnapshotAt(types.BytesToHash(root))
+	require.NoError(t, err)
+
+	_, head, err := newSnap.Commit(flatTestBlocks(t, s)[2])
+	require.NoError(t, err)
+
+	require.NoError(t, s.FlushFlatSnapshot(types.BytesToHash(head)))
+
+	data, ok, err := storage.Get(flatRootKey)
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, head, data)
+
+	// the flushed flat snapshot is loaded without being regenerated
+	s = NewState(storage)
+	require.NoError(t, s.EnableFlatSnapshot(types.BytesToHash(head), hclog.NewNullLogger()))
+	requireFlatMatchesTrie(t, s, types.BytesToHash(head))
+}
+
+func TestFlatSnapshot_GenerateInBackground(t *testing.T) {
+	t.Parallel()
+
+	storage := &hookStorage{PrunableStorage: NewMemoryStorage().(PrunableStorage)}
+	blocks := flatTestBlocks(t, NewState(storage))
+
+	_, root, err := NewState(storage).NewSnapshot().Commit(blocks[0])
+	require.NoError(t, err)
+
+	// hold the generation until the block on top of the generated state is committed
+	releaseCh := make(chan struct{})
+	storage.beforeIterate = func() {
+		<-releaseCh
+	}
+
+	s := NewState(storage)
+	require.NoError(t, s.EnableFlatSnapshot(types.BytesToHash(root), hclog.NewNullLogger()))
+
+	// the reads are served by the trie until the flat snapshot is generated
+	snap, err := s.NewSnapshotAt(types.BytesToHash(root))
+	require.NoError(t, err)
+
+	_, ok := snap.(*Snapshot).getFlatAccount(hashit(flatAddr1.Bytes()))
+	require.False(t, ok)
+
+	account, err := snap.GetAccount(flatAddr1)
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(10), account.Balance)
+
+	_, head, err := snap.Commit(blocks[1])
+	require.NoError(t, err)
+
+	close(releaseCh)
+	<-s.flat.doneCh
+
+	// the block committed during the generation is served by the flat snapshot
+	requireFlatMatchesTrie(t, s, types.BytesToHash(head))
+}
+
+func TestFlatSnapshot_FlushStopsGeneration(t *testing.T) {
+	t.Parallel()
+
+	storage := &hookStorage{PrunableStorage: NewMemoryStorage().(PrunableStorage)}
+
+	_, root, err := NewState(storage).NewSnapshot().Commit(flatTestBlocks(t, NewState(storage))[0])
+	require.NoError(t, err)
+
+	s := NewState(storage)
+
+	// hold the generation until it is stopped, so that no flat entries are written
+	enabledCh := make(chan struct{})
+	storage.beforeIterate = func() {
+		<-enabledCh
+		<-s.flat.stopCh
+	}
+
+	require.NoError(t, s.EnableFlatSnapshot(types.BytesToHash(root), hclog.NewNullLogger()))
+	close(enabledCh)
+
+	require.NoError(t, s.FlushFlatSnapshot(types.BytesToHash(root)))
+	require.False(t, s.flat.has(types.BytesToHash(root)))
+
+	// the flat snapshot is generated again on the next start
+	_, ok, err := storage.Get(flatRootKey)
+	require.NoError(t, err)
+	require.False(t, ok)
+}
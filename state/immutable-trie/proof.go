@@ -0,0 +1,170 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/umbracle/fastrlp"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// ErrProofNodeMissing is returned when a node referenced by the proof path is not part of the proof
+	ErrProofNodeMissing = errors.New("proof node missing")
+
+	// ErrProofInvalidNode is returned when a proof node can not be decoded
+	ErrProofInvalidNode = errors.New("invalid proof node")
+)
+
+// Prove returns the merkle proof for the given key. The proof is a list of the
+// RLP encoded trie nodes on the path from the root towards the key, where the first
+// element is the root node. Nodes embedded into their parents are not listed separately.
+// If the key is not present in the trie, the returned proof proves its absence.
+func (t *Trie) Prove(key []byte, storage Storage) ([][]byte, error) {
+	if t.root == nil {
+		return [][]byte{}, nil
+	}
+
+	root, err := t.Txn(storage).Hash()
+	if err != nil {
+		return nil, err
+	}
+
+	return ProveAt(root, key, storage)
+}
+
+// ProveAt returns the merkle proof for the given key in the trie with the given root
+func ProveAt(root []byte, key []byte, storage Storage) ([][]byte, error) {
+	proof := [][]byte{}
+
+	if bytes.Equal(root, emptyRoot) {
+		return proof, nil
+	}
+
+	hash, search := root, bytesToHexNibbles(key)
+
+	for hash != nil {
+		data, ok, err := storage.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if !ok || len(data) == 0 {
+			return nil, fmt.Errorf("trie node %s not found", hex.EncodeToHex(hash))
+		}
+
+		proof = append(proof, data)
+
+		node, err := decodeProofNode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, search, _ = proofStep(node, search)
+	}
+
+	return proof, nil
+}
+
+// VerifyProof checks the merkle proof for the given key against the given root
+// and returns the value stored under the key. A nil value with a nil error means
+// that the proof proves the absence of the key.
+func VerifyProof(root types.Hash, key []byte, proof [][]byte) ([]byte, error) {
+	if root == types.EmptyRootHash && len(proof) == 0 {
+		return nil, nil
+	}
+
+	nodes := make(map[types.Hash][]byte, len(proof))
+	for _, data := range proof {
+		nodes[types.BytesToHash(hashit(data))] = data
+	}
+
+	hash, search := root.Bytes(), bytesToHexNibbles(key)
+
+	for {
+		data, ok := nodes[types.BytesToHash(hash)]
+		if !ok {
+			return nil, fmt.Errorf("%w: %s", ErrProofNodeMissing, hex.EncodeToHex(hash))
+		}
+
+		node, err := decodeProofNode(data)
+		if err != nil {
+			return nil, err
+		}
+
+		var value []byte
+
+		hash, search, value = proofStep(node, search)
+		if hash == nil {
+			return value, nil
+		}
+	}
+}
+
+// decodeProofNode decodes a single RLP encoded trie node
+func decodeProofNode(data []byte) (Node, error) {
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProofInvalidNode, err)
+	}
+
+	if v.Type() != fastrlp.TypeArray {
+		return nil, fmt.Errorf("%w: node should be an array", ErrProofInvalidNode)
+	}
+
+	n, err := decodeNode(v, nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrProofInvalidNode, err)
+	}
+
+	return n, nil
+}
+
+// proofStep follows the search key through the given node and all of its embedded children.
+// It returns the hash of the next node to resolve together with the remaining part of the key,
+// or the value stored under the key once the path ends (nil if the key does not exist)
+func proofStep(node Node, search []byte) ([]byte, []byte, []byte) {
+	for {
+		switch n := node.(type) {
+		case nil:
+			return nil, nil, nil
+
+		case *ValueNode:
+			if n.hash {
+				return n.buf, search, nil
+			}
+
+			if len(search) == 0 || (len(search) == 1 && search[0] == 16) {
+				return nil, nil, n.buf
+			}
+
+			return nil, nil, nil
+
+		case *ShortNode:
+			plen := len(n.key)
+			if plen > len(search) || !bytes.Equal(search[:plen], n.key) {
+				return nil, nil, nil
+			}
+
+			node, search = n.child, search[plen:]
+
+		case *FullNode:
+			if len(search) == 0 {
+				node = n.value
+
+				continue
+			}
+
+			node, search = n.getEdge(search[0]), search[1:]
+
+		default:
+			return nil, nil, nil
+		}
+	}
+}
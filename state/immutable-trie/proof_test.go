@@ -0,0 +1,127 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTrie_ProveAndVerify(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	txn := NewTrie().Txn(storage)
+	txn.batch = storage.Batch()
+
+	keys := make([][]byte, 0, 100)
+
+	for i := 0; i < 100; i++ {
+		key := crypto.Keccak256(big.NewInt(int64(i)).Bytes())
+		keys = append(keys, key)
+		txn.Insert(key, []byte{byte(i), 0x1, 0x2})
+	}
+
+	root, err := txn.Hash()
+	require.NoError(t, err)
+
+	trie := txn.Commit()
+
+	for i, key := range keys {
+		proof, err := trie.Prove(key, storage)
+		require.NoError(t, err)
+		require.NotEmpty(t, proof)
+
+		value, err := VerifyProof(types.BytesToHash(root), key, proof)
+		require.NoError(t, err)
+		require.Equal(t, []byte{byte(i), 0x1, 0x2}, value)
+	}
+
+	// proof of absence
+	missingKey := crypto.Keccak256([]byte("missing"))
+
+	proof, err := trie.Prove(missingKey, storage)
+	require.NoError(t, err)
+
+	value, err := VerifyProof(types.BytesToHash(root), missingKey, proof)
+	require.NoError(t, err)
+	require.Nil(t, value)
+
+	// tampered proof
+	proof, err = trie.Prove(keys[0], storage)
+	require.NoError(t, err)
+
+	proof[len(proof)-1] = append([]byte{}, proof[len(proof)-1]...)
+	proof[len(proof)-1][len(proof[len(proof)-1])-1] ^= 0xff
+
+	_, err = VerifyProof(types.BytesToHash(root), keys[0], proof)
+	require.ErrorIs(t, err, ErrProofNodeMissing)
+}
+
+func TestTrie_ProveEmpty(t *testing.T) {
+	t.Parallel()
+
+	proof, err := NewTrie().Prove(crypto.Keccak256([]byte{0x1}), NewMemoryStorage())
+	require.NoError(t, err)
+	require.Empty(t, proof)
+
+	value, err := VerifyProof(types.EmptyRootHash, crypto.Keccak256([]byte{0x1}), proof)
+	require.NoError(t, err)
+	require.Nil(t, value)
+}
+
+func TestSnapshot_AccountAndStorageProof(t *testing.T) {
+	t.Parallel()
+
+	addr := types.StringToAddress("0x1")
+	slot := types.StringToHash("0x2")
+	slotValue := types.StringToHash("0x1234")
+
+	snap := NewState(NewMemoryStorage()).NewSnapshot()
+
+	newSnap, rootBytes, err := snap.Commit([]*state.Object{
+		{
+			Address:  addr,
+			Balance:  big.NewInt(100),
+			Nonce:    2,
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+			Storage: []*state.StorageObject{
+				{Key: slot.Bytes(), Val: slotValue.Bytes()},
+			},
+		},
+		{
+			Address:  types.StringToAddress("0x3"),
+			Balance:  big.NewInt(1),
+			CodeHash: types.EmptyCodeHash,
+			Root:     types.EmptyRootHash,
+		},
+	})
+	require.NoError(t, err)
+
+	prover, ok := newSnap.(state.SnapshotProver)
+	require.True(t, ok)
+
+	accountProof, err := prover.GetAccountProof(addr)
+	require.NoError(t, err)
+
+	data, err := VerifyProof(types.BytesToHash(rootBytes), crypto.Keccak256(addr.Bytes()), accountProof)
+	require.NoError(t, err)
+
+	var account state.Account
+	require.NoError(t, account.UnmarshalRlp(data))
+	require.Equal(t, uint64(2), account.Nonce)
+	require.Equal(t, big.NewInt(100), account.Balance)
+
+	storageProof, err := prover.GetStorageProof(account.Root, slot)
+	require.NoError(t, err)
+
+	data, err = VerifyProof(account.Root, crypto.Keccak256(slot.Bytes()), storageProof)
+	require.NoError(t, err)
+	require.NotNil(t, data)
+	require.Equal(t, slotValue, newSnap.GetStorage(addr, account.Root, slot))
+}
@@ -0,0 +1,190 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// ErrPruningNotSupported is returned when the trie storage does not support the removal of entries
+	ErrPruningNotSupported = errors.New("trie storage does not support pruning")
+	// ErrRetainedNodeMissing is returned when a node reachable from a retained state root is missing
+	ErrRetainedNodeMissing = errors.New("node of a retained state is missing")
+	// ErrPruneInProgress is returned when the state is already being pruned
+	ErrPruneInProgress = errors.New("state prune is already in progress")
+)
+
+// PruneResult holds the statistics of a prune run
+type PruneResult struct {
+	// Retained is the number of trie nodes and contract codes kept in the storage
+	Retained int
+	// Pruned is the number of trie nodes and contract codes removed from the storage
+	Pruned int
+}
+
+// Prune removes all the trie nodes and contract codes from the storage which are not
+// reachable from the given state roots (mark-and-sweep). The storage must not be written
+// to while the prune is in progress, since newly written nodes are not marked
+func Prune(storage PrunableStorage, roots []types.Hash) (*PruneResult, error) {
+	return prune(storage, roots, func(storage PrunableStorage, k []byte) (bool, error) {
+		return true, storage.Delete(k)
+	})
+}
+
+// prune marks the entries reachable from the given state roots
+// and removes the unmarked ones with the given delete function,
+// which reports whether the entry has actually been removed
+func prune(
+	storage PrunableStorage,
+	roots []types.Hash,
+	deleteFn func(storage PrunableStorage, k []byte) (bool, error),
+) (*PruneResult, error) {
+	marked, err := markReachable(storage, roots)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &PruneResult{Retained: len(marked)}
+
+	var deleteErr error
+
+	err = storage.Iterate(nil, func(k []byte) bool {
+		if !isPrunableKey(k) {
+			return true
+		}
+
+		if _, ok := marked[string(k)]; ok {
+			return true
+		}
+
+		var deleted bool
+
+		if deleted, deleteErr = deleteFn(storage, k); deleteErr != nil {
+			return false
+		}
+
+		if deleted {
+			res.Pruned++
+		} else {
+			res.Retained++
+		}
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if deleteErr != nil {
+		return nil, deleteErr
+	}
+
+	return res, nil
+}
+
+// isPrunableKey returns true if the key belongs to a trie node or a contract code
+func isPrunableKey(k []byte) bool {
+	return len(k) == types.HashLength ||
+		(len(k) == len(codePrefix)+types.HashLength && bytes.HasPrefix(k, codePrefix))
+}
+
+// markReachable returns the keys of all the trie nodes and contract codes reachable from the given state roots
+func markReachable(storage Storage, roots []types.Hash) (map[string]struct{}, error) {
+	m := &marker{
+		storage: storage,
+		marked:  make(map[string]struct{}),
+	}
+
+	for _, root := range roots {
+		if root == types.EmptyRootHash || root == types.ZeroHash {
+			continue
+		}
+
+		if err := m.markHash(root.Bytes(), false); err != nil {
+			return nil, fmt.Errorf("failed to mark state %s: %w", root, err)
+		}
+	}
+
+	return m.marked, nil
+}
+
+type marker struct {
+	storage Storage
+	marked  map[string]struct{}
+}
+
+func (m *marker) markHash(hash []byte, isStorage bool) error {
+	if _, ok := m.marked[string(hash)]; ok {
+		// the whole subtree is already marked
+		return nil
+	}
+
+	data, ok, err := m.storage.Get(hash)
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrRetainedNodeMissing, types.BytesToHash(hash))
+	}
+
+	m.marked[string(hash)] = struct{}{}
+
+	node, err := decodeStoredNode(data, m.storage)
+	if err != nil {
+		return err
+	}
+
+	return m.markNode(node, isStorage)
+}
+
+func (m *marker) markNode(node Node, isStorage bool) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *ValueNode:
+		if n.hash {
+			return m.markHash(n.buf, isStorage)
+		}
+
+		if isStorage {
+			return nil
+		}
+
+		// leaf of the account trie
+		var account state.Account
+		if err := account.UnmarshalRlp(n.buf); err != nil {
+			return err
+		}
+
+		if len(account.CodeHash) != 0 && !bytes.Equal(account.CodeHash, emptyCodeHash) {
+			m.marked[string(GetCodeKey(types.BytesToHash(account.CodeHash)))] = struct{}{}
+		}
+
+		if account.Root != types.EmptyRootHash && account.Root != types.ZeroHash {
+			return m.markHash(account.Root.Bytes(), true)
+		}
+
+	case *ShortNode:
+		return m.markNode(n.child, isStorage)
+
+	case *FullNode:
+		for _, child := range n.children {
+			if err := m.markNode(child, isStorage); err != nil {
+				return err
+			}
+		}
+
+		return m.markNode(n.value, isStorage)
+
+	default:
+		return fmt.Errorf("unknown node type %T", node)
+	}
+
+	return nil
+}
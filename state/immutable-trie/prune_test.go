@@ -0,0 +1,232 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// commitBlocks commits a state per block, each of which changes the balance and a storage slot
+// of the same account, and returns the committed state roots
+func commitBlocks(t *testing.T, s *State, blocks int) []types.Hash {
+	t.Helper()
+
+	addr := types.StringToAddress("0x1")
+	code := []byte{0x60, 0x01}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	require.NoError(t, s.SetCode(codeHash, code))
+
+	roots := make([]types.Hash, 0, blocks)
+	snap := s.NewSnapshot()
+
+	for i := 1; i <= blocks; i++ {
+		newSnap, root, err := snap.Commit([]*state.Object{
+			{
+				Address:  addr,
+				Balance:  big.NewInt(int64(i)),
+				CodeHash: codeHash,
+				Root:     types.EmptyRootHash,
+				Storage: []*state.StorageObject{
+					{Key: types.StringToHash("0x1").Bytes(), Val: big.NewInt(int64(i)).Bytes()},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		roots = append(roots, types.BytesToHash(root))
+		snap = newSnap
+	}
+
+	return roots
+}
+
+func TestPrune(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	roots := commitBlocks(t, NewState(storage), 5)
+
+	res, err := Prune(storage.(PrunableStorage), roots[3:])
+	require.NoError(t, err)
+	require.NotZero(t, res.Pruned)
+	require.NotZero(t, res.Retained)
+
+	// a fresh state does not have any of the tries cached
+	s := NewState(storage)
+
+	for i, root := range roots {
+		snap, err := s.NewSnapshotAt(root)
+		if i < 3 {
+			require.ErrorIs(t, err, state.ErrStateNotAvailable)
+
+			continue
+		}
+
+		require.NoError(t, err)
+
+		account, err := snap.GetAccount(types.StringToAddress("0x1"))
+		require.NoError(t, err)
+		require.Equal(t, big.NewInt(int64(i+1)), account.Balance)
+
+		code, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+		require.True(t, ok)
+		require.Equal(t, []byte{0x60, 0x01}, code)
+
+		value := snap.GetStorage(types.StringToAddress("0x1"), account.Root, types.StringToHash("0x1"))
+		require.Equal(t, types.BytesToHash(big.NewInt(int64(i+1)).Bytes()), value)
+	}
+
+	// pruning again with the same roots does not remove anything
+	res, err = Prune(storage.(PrunableStorage), roots[3:])
+	require.NoError(t, err)
+	require.Zero(t, res.Pruned)
+}
+
+func TestPrune_MissingRetainedNode(t *testing.T) {
+	t.Parallel()
+
+	_, err := Prune(NewMemoryStorage().(PrunableStorage), []types.Hash{types.StringToHash("0x1")})
+	require.ErrorIs(t, err, ErrRetainedNodeMissing)
+}
+
+func TestState_PruneRetainsRecentRoots(t *testing.T) {
+	t.Parallel()
+
+	s := NewState(NewMemoryStorage())
+	s.EnablePruning()
+
+	roots := commitBlocks(t, s, 3)
+
+	// the roots committed since the last prune are retained even if they are not passed
+	_, err := s.Prune(nil)
+	require.NoError(t, err)
+
+	for _, root := range roots {
+		_, err := s.NewSnapshotAt(root)
+		require.NoError(t, err)
+	}
+
+	// once the roots are pruned without being retained explicitly, the state is not available anymore
+	_, err = s.Prune(roots[2:])
+	require.NoError(t, err)
+
+	_, err = s.NewSnapshotAt(roots[0])
+	require.ErrorIs(t, err, state.ErrStateNotAvailable)
+
+	_, err = s.NewSnapshotAt(roots[2])
+	require.NoError(t, err)
+}
+
+// hookStorage calls the hook before the storage is iterated, that is between the mark and the sweep of a prune
+type hookStorage struct {
+	PrunableStorage
+
+	beforeIterate func()
+}
+
+func (h *hookStorage) Iterate(prefix []byte, handler func(k []byte) bool) error {
+	if hook := h.beforeIterate; hook != nil {
+		h.beforeIterate = nil
+		hook()
+	}
+
+	return h.PrunableStorage.Iterate(prefix, handler)
+}
+
+func TestState_PruneDoesNotBlockCommits(t *testing.T) {
+	t.Parallel()
+
+	storage := &hookStorage{PrunableStorage: NewMemoryStorage().(PrunableStorage)}
+
+	s := NewState(storage)
+	s.EnablePruning()
+
+	roots := commitBlocks(t, s, 3)
+
+	_, err := s.Prune(nil)
+	require.NoError(t, err)
+
+	// the block committed while the prune is sweeping the storage is not pruned,
+	// even though its nodes are not reachable from the retained roots
+	var committed types.Hash
+
+	storage.beforeIterate = func() {
+		snap, err := s.NewSnapshotAt(roots[2])
+		require.NoError(t, err)
+
+		_, root, err := snap.Commit([]*state.Object{
+			{
+				Address: types.StringToAddress("0x1"),
+				Balance: big.NewInt(100),
+				Root:    types.EmptyRootHash,
+				Storage: []*state.StorageObject{
+					{Key: types.StringToHash("0x1").Bytes(), Val: big.NewInt(100).Bytes()},
+				},
+			},
+		})
+		require.NoError(t, err)
+
+		committed = types.BytesToHash(root)
+	}
+
+	_, err = s.Prune(roots[2:])
+	require.NoError(t, err)
+
+	_, err = NewState(storage).NewSnapshotAt(roots[0])
+	require.ErrorIs(t, err, state.ErrStateNotAvailable)
+
+	// the committed block is retained by the next prune as well
+	_, err = s.Prune(roots[2:])
+	require.NoError(t, err)
+
+	snap, err := NewState(storage).NewSnapshotAt(committed)
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(types.StringToAddress("0x1"))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(100), account.Balance)
+
+	value := snap.GetStorage(types.StringToAddress("0x1"), account.Root, types.StringToHash("0x1"))
+	require.Equal(t, types.BytesToHash(big.NewInt(100).Bytes()), value)
+}
+
+func TestState_PrunedStateReadFails(t *testing.T) {
+	t.Parallel()
+
+	storage := NewMemoryStorage()
+	s := NewState(storage)
+
+	// enough accounts for the account trie nodes to be stored separately
+	objects := make([]*state.Object, 0, 16)
+	for i := 0; i < 16; i++ {
+		objects = append(objects, &state.Object{
+			Address: types.BytesToAddress([]byte{byte(i + 1)}),
+			Balance: big.NewInt(1),
+			Root:    types.EmptyRootHash,
+		})
+	}
+
+	_, stale, err := s.NewSnapshot().Commit(objects)
+	require.NoError(t, err)
+
+	objects[0].Balance = big.NewInt(2)
+
+	_, retained, err := s.NewSnapshot().Commit(objects)
+	require.NoError(t, err)
+
+	// the snapshot is opened before its state is pruned
+	snap, err := NewState(storage).NewSnapshotAt(types.BytesToHash(stale))
+	require.NoError(t, err)
+
+	_, err = s.Prune([]types.Hash{types.BytesToHash(retained)})
+	require.NoError(t, err)
+
+	_, err = snap.GetAccount(objects[0].Address)
+	require.ErrorIs(t, err, state.ErrStateNotAvailable)
+}
@@ -13,29 +13,30 @@ import (
 type Snapshot struct {
 	state *State
 	trie  *Trie
+	root  types.Hash
 }
 
 var emptyStateHash = types.StringToHash("0x56e81f171bcc55a6ff8345e692c0f86e5b48e01b996cadc001622fb5e363b421")
 
 func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.Hash) types.Hash {
-	var (
-		err  error
-		trie *Trie
-	)
-
 	if root == emptyStateHash {
-		trie = s.state.newTrie()
-	} else {
-		trie, err = s.state.newTrieAt(root)
-		if err != nil {
-			return types.Hash{}
-		}
+		return types.Hash{}
 	}
 
 	key := crypto.Keccak256(rawkey.Bytes())
 
-	val, ok := trie.Get(key, s.state.storage)
+	val, ok := s.getFlatStorage(addr, root, key)
 	if !ok {
+		trie, err := s.state.newTrieAt(root)
+		if err != nil {
+			return types.Hash{}
+		}
+
+		// the storage reads can't fail, the missing state is reported by the account reads
+		val, _, _ = trie.Get(key, s.state.storage)
+	}
+
+	if val == nil {
 		return types.Hash{}
 	}
 
@@ -57,8 +58,16 @@ func (s *Snapshot) GetStorage(addr types.Address, root types.Hash, rawkey types.
 func (s *Snapshot) GetAccount(addr types.Address) (*state.Account, error) {
 	key := crypto.Keccak256(addr.Bytes())
 
-	data, ok := s.trie.Get(key, s.state.storage)
+	data, ok := s.getFlatAccount(key)
 	if !ok {
+		var err error
+
+		if data, _, err = s.trie.Get(key, s.state.storage); err != nil {
+			return nil, err
+		}
+	}
+
+	if data == nil {
 		return nil, nil
 	}
 
@@ -74,8 +83,37 @@ func (s *Snapshot) GetCode(hash types.Hash) ([]byte, bool) {
 	return s.state.GetCode(hash)
 }
 
+// getFlatAccount reads the account with the given hashed address from the flat snapshot.
+// The second return value is false if the flat snapshot does not cover the state
+func (s *Snapshot) getFlatAccount(key []byte) ([]byte, bool) {
+	if s.state.flat == nil {
+		return nil, false
+	}
+
+	return s.state.flat.getAccount(s.root, types.BytesToHash(key))
+}
+
+// getFlatStorage reads the storage slot with the given hashed key from the flat snapshot.
+// The second return value is false if the flat snapshot does not cover the storage with the given root
+func (s *Snapshot) getFlatStorage(addr types.Address, root types.Hash, key []byte) ([]byte, bool) {
+	accountKey := crypto.Keccak256(addr.Bytes())
+
+	data, ok := s.getFlatAccount(accountKey)
+	if !ok || data == nil {
+		return nil, false
+	}
+
+	// the flat storage belongs to the account of the snapshot, which might differ from the requested one
+	var account state.Account
+	if err := account.UnmarshalRlp(data); err != nil || account.Root != root {
+		return nil, false
+	}
+
+	return s.state.flat.getStorage(s.root, types.BytesToHash(accountKey), types.BytesToHash(key))
+}
+
 func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error) {
-	batch := s.state.storage.Batch()
+	batch := &recordingBatch{Batch: s.state.storage.Batch()}
 
 	tt := s.trie.Txn(s.state.storage)
 	tt.batch = batch
@@ -83,9 +121,22 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 	arena := stateArenaPool.Get()
 	defer stateArenaPool.Put(arena)
 
+	var diff *flatDiffLayer
+	if s.state.flat != nil && s.state.flat.has(s.root) {
+		diff = newFlatDiffLayer()
+	}
+
 	for _, obj := range objs {
+		accountKey := hashit(obj.Address.Bytes())
+
 		if obj.Deleted {
-			tt.Delete(hashit(obj.Address.Bytes()))
+			tt.Delete(accountKey)
+
+			if diff != nil {
+				accountHash := types.BytesToHash(accountKey)
+				diff.accounts[accountHash] = nil
+				diff.destructs[accountHash] = struct{}{}
+			}
 		} else {
 			account := state.Account{
 				Balance:  obj.Balance,
@@ -94,6 +145,12 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 				Root:     obj.Root, // old root
 			}
 
+			if diff != nil {
+				if err := s.diffStorageBase(diff, obj); err != nil {
+					return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit failed to diff storage: %w", err)
+				}
+			}
+
 			if len(obj.Storage) != 0 {
 				trie, err := s.state.newTrieAt(obj.Root)
 				if err != nil {
@@ -107,9 +164,12 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 					k := hashit(entry.Key)
 					if entry.Deleted {
 						localTxn.Delete(k)
+						diff.setStorage(accountKey, k, nil)
 					} else {
 						vv := arena.NewBytes(bytes.TrimLeft(entry.Val, "\x00"))
-						localTxn.Insert(k, vv.MarshalTo(nil))
+						data := vv.MarshalTo(nil)
+						localTxn.Insert(k, data)
+						diff.setStorage(accountKey, k, data)
 					}
 				}
 
@@ -129,8 +189,12 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 			vv := account.MarshalWith(arena)
 			data := vv.MarshalTo(nil)
 
-			tt.Insert(hashit(obj.Address.Bytes()), data)
+			tt.Insert(accountKey, data)
 			arena.Reset()
+
+			if diff != nil {
+				diff.accounts[types.BytesToHash(accountKey)] = data
+			}
 		}
 	}
 
@@ -142,11 +206,60 @@ func (s *Snapshot) Commit(objs []*state.Object) (state.Snapshot, []byte, error)
 	nTrie := tt.Commit()
 
 	// Write all the entries to db
-	if err := batch.Write(); err != nil {
+	if err := s.state.writeBatch(batch, types.BytesToHash(root)); err != nil {
 		return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit db write error: %w", err)
 	}
 
 	s.state.AddState(types.BytesToHash(root), nTrie)
 
-	return &Snapshot{trie: nTrie, state: s.state}, root, nil
+	if diff != nil {
+		if err := s.state.flat.update(s.root, types.BytesToHash(root), diff); err != nil {
+			return nil, types.ZeroHash[:], fmt.Errorf("snapshot commit failed to update flat snapshot: %w", err)
+		}
+	}
+
+	return &Snapshot{trie: nTrie, state: s.state, root: types.BytesToHash(root)}, root, nil
+}
+
+// diffStorageBase records the storage wipe of the account in the diff layer, if the storage
+// of the object is not based on the current storage of the account (e.g. the account was recreated)
+func (s *Snapshot) diffStorageBase(diff *flatDiffLayer, obj *state.Object) error {
+	prevRoot := types.EmptyRootHash
+
+	prev, err := s.GetAccount(obj.Address)
+	if err != nil {
+		return err
+	}
+
+	if prev != nil {
+		prevRoot = prev.Root
+	}
+
+	if prevRoot == obj.Root {
+		return nil
+	}
+
+	accountKey := hashit(obj.Address.Bytes())
+	diff.destructs[types.BytesToHash(accountKey)] = struct{}{}
+
+	return walkLeaves(s.state.storage, obj.Root, func(key, value []byte) error {
+		diff.setStorage(accountKey, key, value)
+
+		return nil
+	})
+}
+
+// GetAccountProof returns the merkle proof of the given account in the state trie
+func (s *Snapshot) GetAccountProof(addr types.Address) ([][]byte, error) {
+	return s.trie.Prove(crypto.Keccak256(addr.Bytes()), s.state.storage)
+}
+
+// GetStorageProof returns the merkle proof of the given storage slot in the storage trie with the given root
+func (s *Snapshot) GetStorageProof(root types.Hash, rawkey types.Hash) ([][]byte, error) {
+	trie, err := s.state.newTrieAt(root)
+	if err != nil {
+		return nil, err
+	}
+
+	return trie.Prove(crypto.Keccak256(rawkey.Bytes()), s.state.storage)
 }
@@ -2,7 +2,9 @@ package itrie
 
 import (
 	"fmt"
+	"sync"
 
+	"github.com/hashicorp/go-hclog"
 	lru "github.com/hashicorp/golang-lru"
 
 	"github.com/0xPolygon/polygon-edge/state"
@@ -12,21 +14,37 @@ import (
 type State struct {
 	storage Storage
 	cache   *lru.Cache
+
+	// pruneLock serializes the writes to the storage with the removals of the prune in progress.
+	// It is only held for a single write or removal, so the commits are not blocked by the prune
+	pruneLock sync.Mutex
+	// recentRoots are the roots committed since the last prune,
+	// which might not be referenced by the blockchain yet.
+	// They are tracked only if pruning is enabled
+	recentRoots map[types.Hash]struct{}
+	pruning     bool
+	// written are the keys written since the prune in progress has started,
+	// which are not removed by it. It is nil if no prune is in progress
+	written map[string]struct{}
+
+	// flat is the flat snapshot of the recent states, nil if it is not enabled
+	flat *flatSnapshot
 }
 
 func NewState(storage Storage) *State {
 	cache, _ := lru.New(128)
 
 	s := &State{
-		storage: storage,
-		cache:   cache,
+		storage:     storage,
+		cache:       cache,
+		recentRoots: make(map[types.Hash]struct{}),
 	}
 
 	return s
 }
 
 func (s *State) NewSnapshot() state.Snapshot {
-	return &Snapshot{state: s, trie: s.newTrie()}
+	return &Snapshot{state: s, trie: s.newTrie(), root: types.EmptyRootHash}
 }
 
 func (s *State) NewSnapshotAt(root types.Hash) (state.Snapshot, error) {
@@ -35,7 +53,7 @@ func (s *State) NewSnapshotAt(root types.Hash) (state.Snapshot, error) {
 		return nil, err
 	}
 
-	return &Snapshot{state: s, trie: t}, nil
+	return &Snapshot{state: s, trie: t, root: root}, nil
 }
 
 func (s *State) newTrie() *Trie {
@@ -43,7 +61,16 @@ func (s *State) newTrie() *Trie {
 }
 
 func (s *State) SetCode(hash types.Hash, code []byte) error {
-	return s.storage.SetCode(hash, code)
+	s.pruneLock.Lock()
+	defer s.pruneLock.Unlock()
+
+	if err := s.storage.SetCode(hash, code); err != nil {
+		return err
+	}
+
+	s.markWritten(GetCodeKey(hash))
+
+	return nil
 }
 
 func (s *State) GetCode(hash types.Hash) ([]byte, bool) {
@@ -77,7 +104,7 @@ func (s *State) newTrieAt(root types.Hash) (*Trie, error) {
 	}
 
 	if !ok {
-		return nil, fmt.Errorf("state not found at hash %s", root)
+		return nil, fmt.Errorf("%w at hash %s", state.ErrStateNotAvailable, root)
 	}
 
 	t := &Trie{
@@ -90,3 +117,142 @@ func (s *State) newTrieAt(root types.Hash) (*Trie, error) {
 func (s *State) AddState(root types.Hash, t *Trie) {
 	s.cache.Add(root, t)
 }
+
+// EnableFlatSnapshot makes the accounts and the storage slots of the given state and the states
+// committed on top of it readable from the flat snapshot. The flat snapshot is regenerated
+// from the trie in the background if the persisted one does not match the given root,
+// the reads are served by the trie until the generation is done.
+// It must be called before the state is used
+func (s *State) EnableFlatSnapshot(root types.Hash, logger hclog.Logger) error {
+	storage, ok := s.storage.(PrunableStorage)
+	if !ok {
+		return ErrFlatSnapshotNotSupported
+	}
+
+	flat, err := newFlatSnapshot(storage, root, logger.Named("flat_snapshot"))
+	if err != nil {
+		return err
+	}
+
+	s.flat = flat
+
+	return nil
+}
+
+// FlushFlatSnapshot persists the flat snapshot of the given state, so that it does not need
+// to be regenerated on the next start. The generation in progress is stopped,
+// so it must be called only when the state is being closed
+func (s *State) FlushFlatSnapshot(root types.Hash) error {
+	if s.flat == nil {
+		return nil
+	}
+
+	return s.flat.flush(root)
+}
+
+// Prune removes all the trie nodes which are not reachable from the given state roots
+// or from the roots committed since the previous prune. The commits are not blocked while pruning:
+// the entries written since the prune has started are not removed by it
+func (s *State) Prune(roots []types.Hash) (res *PruneResult, err error) {
+	storage, ok := s.storage.(PrunableStorage)
+	if !ok {
+		return nil, ErrPruningNotSupported
+	}
+
+	s.pruneLock.Lock()
+
+	if s.written != nil {
+		s.pruneLock.Unlock()
+
+		return nil, ErrPruneInProgress
+	}
+
+	retained := make([]types.Hash, 0, len(roots)+len(s.recentRoots))
+	retained = append(retained, roots...)
+
+	for root := range s.recentRoots {
+		retained = append(retained, root)
+	}
+
+	recentRoots := s.recentRoots
+	s.recentRoots = make(map[types.Hash]struct{})
+	s.written = make(map[string]struct{})
+
+	s.pruneLock.Unlock()
+
+	defer func() {
+		s.pruneLock.Lock()
+		defer s.pruneLock.Unlock()
+
+		s.written = nil
+
+		// the roots are retained by the next prune if this one has failed
+		if err != nil {
+			for root := range recentRoots {
+				s.recentRoots[root] = struct{}{}
+			}
+		}
+	}()
+
+	res, err = prune(storage, retained, s.deleteUnwritten)
+	if err != nil {
+		return nil, err
+	}
+
+	// cached tries might reference the pruned nodes
+	s.cache.Purge()
+
+	return res, nil
+}
+
+// EnablePruning makes the state track the committed roots, so that they are retained
+// by the next prune even if they are not referenced by the blockchain yet.
+// It must be called before any commit if the state is going to be pruned
+func (s *State) EnablePruning() {
+	s.pruneLock.Lock()
+	defer s.pruneLock.Unlock()
+
+	s.pruning = true
+}
+
+// writeBatch writes the batch of the committed root to the storage.
+// The written entries are protected from the prune in progress,
+// and the root is retained by the next prune
+func (s *State) writeBatch(batch *recordingBatch, root types.Hash) error {
+	s.pruneLock.Lock()
+	defer s.pruneLock.Unlock()
+
+	if err := batch.Write(); err != nil {
+		return err
+	}
+
+	for _, k := range batch.keys {
+		s.markWritten(k)
+	}
+
+	if s.pruning {
+		s.recentRoots[root] = struct{}{}
+	}
+
+	return nil
+}
+
+// markWritten protects the written key from the prune in progress.
+// It must be called with the prune lock held
+func (s *State) markWritten(k []byte) {
+	if s.written != nil {
+		s.written[string(k)] = struct{}{}
+	}
+}
+
+// deleteUnwritten removes the entry unless it has been written since the prune has started
+func (s *State) deleteUnwritten(storage PrunableStorage, k []byte) (bool, error) {
+	s.pruneLock.Lock()
+	defer s.pruneLock.Unlock()
+
+	if _, ok := s.written[string(k)]; ok {
+		return false, nil
+	}
+
+	return true, storage.Delete(k)
+}
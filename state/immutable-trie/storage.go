@@ -1,6 +1,7 @@
 package itrie
 
 import (
+	"bytes"
 	"fmt"
 	"sync"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/syndtr/goleveldb/leveldb"
+	"github.com/syndtr/goleveldb/leveldb/util"
 	"github.com/umbracle/fastrlp"
 )
 
@@ -25,10 +27,24 @@ var (
 type Batch interface {
 	// Put puts key and value into batch. It can not return error because actual writing is done with Write method
 	Put(k, v []byte)
+	// Delete deletes the key from the database. Like Put, it takes effect on Write
+	Delete(k []byte)
 	// Write writes all the key values pair previosly putted with Put method to the database
 	Write() error
 }
 
+// recordingBatch is a batch which keeps the keys put into it
+type recordingBatch struct {
+	Batch
+
+	keys [][]byte
+}
+
+func (b *recordingBatch) Put(k, v []byte) {
+	b.keys = append(b.keys, append([]byte(nil), k...))
+	b.Batch.Put(k, v)
+}
+
 // Storage stores the trie
 type Storage interface {
 	Put(k, v []byte) error
@@ -40,6 +56,16 @@ type Storage interface {
 	Close() error
 }
 
+// PrunableStorage is a trie storage which supports the removal of stored entries
+type PrunableStorage interface {
+	Storage
+
+	// Delete removes the entry with the given key
+	Delete(k []byte) error
+	// Iterate calls the handler for each stored key with the given prefix until the handler returns false
+	Iterate(prefix []byte, handler func(k []byte) bool) error
+}
+
 // KVStorage is a k/v storage on memory using leveldb
 type KVStorage struct {
 	db *leveldb.DB
@@ -55,6 +81,10 @@ func (b *KVBatch) Put(k, v []byte) {
 	b.batch.Put(k, v)
 }
 
+func (b *KVBatch) Delete(k []byte) {
+	b.batch.Delete(k)
+}
+
 func (b *KVBatch) Write() error {
 	return b.db.Write(b.batch, nil)
 }
@@ -93,6 +123,28 @@ func (kv *KVStorage) Get(k []byte) ([]byte, bool, error) {
 	return data, true, nil
 }
 
+func (kv *KVStorage) Delete(k []byte) error {
+	return kv.db.Delete(k, nil)
+}
+
+func (kv *KVStorage) Iterate(prefix []byte, handler func(k []byte) bool) error {
+	iter := kv.db.NewIterator(util.BytesPrefix(prefix), nil)
+	defer iter.Release()
+
+	for iter.Next() {
+		if !handler(iter.Key()) {
+			break
+		}
+	}
+
+	return iter.Error()
+}
+
+// Compact compacts the underlying database in order to reclaim the space of the deleted entries
+func (kv *KVStorage) Compact() error {
+	return kv.db.CompactRange(util.Range{})
+}
+
 func (kv *KVStorage) Close() error {
 	return kv.db.Close()
 }
@@ -159,7 +211,45 @@ func (m *memStorage) GetCode(hash types.Hash) ([]byte, bool) {
 }
 
 func (m *memStorage) Batch() Batch {
-	return &memBatch{db: &m.db, l: new(sync.Mutex)}
+	return &memBatch{db: &m.db, l: m.l}
+}
+
+func (m *memStorage) Delete(p []byte) error {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	delete(m.db, hex.EncodeToHex(p))
+
+	return nil
+}
+
+func (m *memStorage) Iterate(prefix []byte, handler func(k []byte) bool) error {
+	m.l.Lock()
+
+	keys := make([][]byte, 0, len(m.db))
+
+	for k := range m.db {
+		key, err := hex.DecodeHex(k)
+		if err != nil {
+			m.l.Unlock()
+
+			return err
+		}
+
+		if bytes.HasPrefix(key, prefix) {
+			keys = append(keys, key)
+		}
+	}
+
+	m.l.Unlock()
+
+	for _, k := range keys {
+		if !handler(k) {
+			break
+		}
+	}
+
+	return nil
 }
 
 func (m *memStorage) Close() error {
@@ -175,6 +265,13 @@ func (m *memBatch) Put(p, v []byte) {
 	(*m.db)[hex.EncodeToHex(p)] = buf
 }
 
+func (m *memBatch) Delete(p []byte) {
+	m.l.Lock()
+	defer m.l.Unlock()
+
+	delete(*m.db, hex.EncodeToHex(p))
+}
+
 func (m *memBatch) Write() error {
 	return nil
 }
@@ -0,0 +1,124 @@
+package itrie
+
+import (
+	"github.com/cockroachdb/pebble"
+	"github.com/hashicorp/go-hclog"
+
+	pebbledb "github.com/0xPolygon/polygon-edge/blockchain/storage/pebble"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// PebbleStorage is a k/v storage of the trie using pebble
+type PebbleStorage struct {
+	db *pebble.DB
+}
+
+// PebbleBatch is a batch write for pebble
+type PebbleBatch struct {
+	batch *pebble.Batch
+}
+
+func (b *PebbleBatch) Put(k, v []byte) {
+	// the batch errors only when it's committed or closed
+	_ = b.batch.Set(k, v, nil)
+}
+
+func (b *PebbleBatch) Delete(k []byte) {
+	_ = b.batch.Delete(k, nil)
+}
+
+func (b *PebbleBatch) Write() error {
+	return b.batch.Commit(pebble.NoSync)
+}
+
+func (ps *PebbleStorage) SetCode(hash types.Hash, code []byte) error {
+	return ps.Put(GetCodeKey(hash), code)
+}
+
+func (ps *PebbleStorage) GetCode(hash types.Hash) ([]byte, bool) {
+	res, ok, err := ps.Get(GetCodeKey(hash))
+	if err != nil {
+		return nil, false
+	}
+
+	return res, ok
+}
+
+func (ps *PebbleStorage) Batch() Batch {
+	return &PebbleBatch{batch: ps.db.NewBatch()}
+}
+
+func (ps *PebbleStorage) Put(k, v []byte) error {
+	return ps.db.Set(k, v, pebble.NoSync)
+}
+
+func (ps *PebbleStorage) Get(k []byte) ([]byte, bool, error) {
+	return pebbledb.Get(ps.db, k)
+}
+
+func (ps *PebbleStorage) Delete(k []byte) error {
+	return ps.db.Delete(k, pebble.NoSync)
+}
+
+func (ps *PebbleStorage) Iterate(prefix []byte, handler func(k []byte) bool) error {
+	iter, err := ps.db.NewIter(pebbledb.PrefixIterOptions(prefix))
+	if err != nil {
+		return err
+	}
+
+	for iter.First(); iter.Valid(); iter.Next() {
+		if !handler(iter.Key()) {
+			break
+		}
+	}
+
+	if err := iter.Error(); err != nil {
+		_ = iter.Close()
+
+		return err
+	}
+
+	return iter.Close()
+}
+
+// Compact compacts the underlying database in order to reclaim the space of the deleted entries
+func (ps *PebbleStorage) Compact() error {
+	iter, err := ps.db.NewIter(nil)
+	if err != nil {
+		return err
+	}
+
+	var first, last []byte
+
+	if iter.First() {
+		first = append(first, iter.Key()...)
+	}
+
+	if iter.Last() {
+		last = append(last, iter.Key()...)
+	}
+
+	if err := iter.Close(); err != nil {
+		return err
+	}
+
+	if first == nil {
+		return nil
+	}
+
+	// the end of the range is exclusive
+	return ps.db.Compact(first, append(last, 0x00), true)
+}
+
+func (ps *PebbleStorage) Close() error {
+	return ps.db.Close()
+}
+
+func NewPebbleStorage(path string, logger hclog.Logger) (Storage, error) {
+	db, err := pebbledb.OpenDB(path, logger.Named("pebble"))
+	if err != nil {
+		return nil, err
+	}
+
+	return &PebbleStorage{db}, nil
+}
@@ -0,0 +1,88 @@
+package itrie
+
+import (
+	"testing"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func newTestPebbleStorage(t *testing.T) *PebbleStorage {
+	t.Helper()
+
+	storage, err := NewPebbleStorage(t.TempDir(), hclog.NewNullLogger())
+	require.NoError(t, err)
+
+	t.Cleanup(func() {
+		require.NoError(t, storage.Close())
+	})
+
+	return storage.(*PebbleStorage)
+}
+
+func TestPebbleStorage(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestPebbleStorage(t)
+
+	require.NoError(t, storage.Put([]byte{0x1}, []byte{0xa}))
+
+	batch := storage.Batch()
+	batch.Put([]byte{0x1, 0x1}, []byte{0xb})
+	batch.Put([]byte{0x2}, []byte{0xc})
+	batch.Delete([]byte{0x1})
+	require.NoError(t, batch.Write())
+
+	_, ok, err := storage.Get([]byte{0x1})
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	value, ok, err := storage.Get([]byte{0x1, 0x1})
+	require.NoError(t, err)
+	require.True(t, ok)
+	require.Equal(t, []byte{0xb}, value)
+
+	require.NoError(t, storage.SetCode(types.StringToHash("0x1"), []byte{0x60}))
+
+	code, ok := storage.GetCode(types.StringToHash("0x1"))
+	require.True(t, ok)
+	require.Equal(t, []byte{0x60}, code)
+
+	keys := make([][]byte, 0)
+
+	require.NoError(t, storage.Iterate([]byte{0x1}, func(k []byte) bool {
+		keys = append(keys, append([]byte{}, k...))
+
+		return true
+	}))
+	require.Equal(t, [][]byte{{0x1, 0x1}}, keys)
+
+	require.NoError(t, storage.Delete([]byte{0x2}))
+
+	_, ok, err = storage.Get([]byte{0x2})
+	require.NoError(t, err)
+	require.False(t, ok)
+}
+
+func TestPebbleStorage_Prune(t *testing.T) {
+	t.Parallel()
+
+	storage := newTestPebbleStorage(t)
+	roots := commitBlocks(t, NewState(storage), 5)
+
+	res, err := Prune(storage, roots[3:])
+	require.NoError(t, err)
+	require.NotZero(t, res.Pruned)
+	require.NoError(t, storage.Compact())
+
+	s := NewState(storage)
+
+	_, err = s.NewSnapshotAt(roots[0])
+	require.ErrorIs(t, err, state.ErrStateNotAvailable)
+
+	_, err = s.NewSnapshotAt(roots[4])
+	require.NoError(t, err)
+}
@@ -0,0 +1,248 @@
+package itrie
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/umbracle/fastrlp"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	// ErrUnexpectedStateData is returned when the delivered trie node or contract code has not been requested
+	ErrUnexpectedStateData = errors.New("unexpected state data")
+)
+
+// StateSync downloads the state of a given root from the remote sources node by node.
+// It tracks the trie nodes and contract codes which are missing from the local storage
+// and stores the delivered ones only after verifying them against their hashes,
+// so the whole synced state is proven by the root it has been started with.
+// The root node is stored the last, hence the state is complete once its root is in the storage
+type StateSync struct {
+	storage Storage
+	root    types.Hash
+
+	// rootData is the delivered root node, which is held until the rest of the state is synced
+	rootData []byte
+
+	// nodes are the hashes of the missing trie nodes, mapped to whether they belong to a storage trie
+	nodes map[types.Hash]bool
+	// codes are the hashes of the missing contract codes
+	codes map[types.Hash]struct{}
+	// visited are the hashes of the stored trie nodes whose children have been scheduled
+	visited map[types.Hash]struct{}
+}
+
+// NewStateSync creates a StateSync for the given state root. The trie nodes which
+// are already in the storage (e.g. left by an interrupted sync) are not requested again
+func NewStateSync(storage Storage, root types.Hash) (*StateSync, error) {
+	s := &StateSync{
+		storage: storage,
+		root:    root,
+		nodes:   make(map[types.Hash]bool),
+		codes:   make(map[types.Hash]struct{}),
+		visited: make(map[types.Hash]struct{}),
+	}
+
+	if err := s.scheduleHash(root, false); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Pending returns the number of the missing trie nodes and contract codes
+func (s *StateSync) Pending() int {
+	return len(s.nodes) + len(s.codes)
+}
+
+// Missing returns the hashes of up to the given number of the missing trie nodes and contract codes
+func (s *StateSync) Missing(maxNodes, maxCodes int) ([]types.Hash, []types.Hash) {
+	nodes := make([]types.Hash, 0, min(maxNodes, len(s.nodes)))
+
+	for hash := range s.nodes {
+		if len(nodes) == maxNodes {
+			break
+		}
+
+		nodes = append(nodes, hash)
+	}
+
+	codes := make([]types.Hash, 0, min(maxCodes, len(s.codes)))
+
+	for hash := range s.codes {
+		if len(codes) == maxCodes {
+			break
+		}
+
+		codes = append(codes, hash)
+	}
+
+	return nodes, codes
+}
+
+// ProcessNode verifies and stores the delivered trie node, and schedules its missing children
+func (s *StateSync) ProcessNode(data []byte) error {
+	hash := types.BytesToHash(crypto.Keccak256(data))
+
+	isStorage, ok := s.nodes[hash]
+	if !ok {
+		return fmt.Errorf("%w: node %s", ErrUnexpectedStateData, hash)
+	}
+
+	node, err := decodeStoredNode(data, s.storage)
+	if err != nil {
+		return fmt.Errorf("failed to decode node %s: %w", hash, err)
+	}
+
+	if hash == s.root {
+		s.rootData = data
+	} else if err := s.storage.Put(hash.Bytes(), data); err != nil {
+		return err
+	}
+
+	delete(s.nodes, hash)
+	s.visited[hash] = struct{}{}
+
+	if err := s.scheduleNode(node, isStorage); err != nil {
+		return err
+	}
+
+	return s.commitRoot()
+}
+
+// ProcessCode verifies and stores the delivered contract code
+func (s *StateSync) ProcessCode(code []byte) error {
+	hash := types.BytesToHash(crypto.Keccak256(code))
+
+	if _, ok := s.codes[hash]; !ok {
+		return fmt.Errorf("%w: code %s", ErrUnexpectedStateData, hash)
+	}
+
+	if err := s.storage.SetCode(hash, code); err != nil {
+		return err
+	}
+
+	delete(s.codes, hash)
+
+	return s.commitRoot()
+}
+
+// commitRoot stores the root node once all the other trie nodes and contract codes are synced
+func (s *StateSync) commitRoot() error {
+	if s.rootData == nil || s.Pending() > 0 {
+		return nil
+	}
+
+	if err := s.storage.Put(s.root.Bytes(), s.rootData); err != nil {
+		return err
+	}
+
+	s.rootData = nil
+
+	return nil
+}
+
+// scheduleHash requests the node with the given hash if it's missing,
+// otherwise it schedules the missing nodes of its subtree
+func (s *StateSync) scheduleHash(hash types.Hash, isStorage bool) error {
+	if hash == types.EmptyRootHash || hash == types.ZeroHash {
+		return nil
+	}
+
+	if _, ok := s.visited[hash]; ok {
+		return nil
+	}
+
+	if _, ok := s.nodes[hash]; ok {
+		return nil
+	}
+
+	data, ok, err := s.storage.Get(hash.Bytes())
+	if err != nil {
+		return err
+	}
+
+	if !ok {
+		s.nodes[hash] = isStorage
+
+		return nil
+	}
+
+	s.visited[hash] = struct{}{}
+
+	node, err := decodeStoredNode(data, s.storage)
+	if err != nil {
+		return fmt.Errorf("failed to decode node %s: %w", hash, err)
+	}
+
+	return s.scheduleNode(node, isStorage)
+}
+
+func (s *StateSync) scheduleNode(node Node, isStorage bool) error {
+	switch n := node.(type) {
+	case nil:
+		return nil
+
+	case *ValueNode:
+		if n.hash {
+			return s.scheduleHash(types.BytesToHash(n.buf), isStorage)
+		}
+
+		if isStorage {
+			return nil
+		}
+
+		// leaf of the account trie
+		var account state.Account
+		if err := account.UnmarshalRlp(n.buf); err != nil {
+			return err
+		}
+
+		if len(account.CodeHash) != 0 && !bytes.Equal(account.CodeHash, emptyCodeHash) {
+			codeHash := types.BytesToHash(account.CodeHash)
+
+			if _, ok := s.storage.GetCode(codeHash); !ok {
+				s.codes[codeHash] = struct{}{}
+			}
+		}
+
+		return s.scheduleHash(account.Root, true)
+
+	case *ShortNode:
+		return s.scheduleNode(n.child, isStorage)
+
+	case *FullNode:
+		for _, child := range n.children {
+			if err := s.scheduleNode(child, isStorage); err != nil {
+				return err
+			}
+		}
+
+		return s.scheduleNode(n.value, isStorage)
+
+	default:
+		return fmt.Errorf("unknown node type %T", node)
+	}
+}
+
+// decodeStoredNode decodes the RLP encoded trie node as it's kept in the storage
+func decodeStoredNode(data []byte, storage Storage) (Node, error) {
+	p := parserPool.Get()
+	defer parserPool.Put(p)
+
+	v, err := p.Parse(data)
+	if err != nil {
+		return nil, err
+	}
+
+	if v.Type() != fastrlp.TypeArray {
+		return nil, fmt.Errorf("storage item should be an array")
+	}
+
+	return decodeNode(v, storage)
+}
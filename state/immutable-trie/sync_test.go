@@ -0,0 +1,167 @@
+package itrie
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/state"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// commitSyncTestState commits a state of the accounts with storage and code, and returns its root
+func commitSyncTestState(t *testing.T, storage Storage) types.Hash {
+	t.Helper()
+
+	code := []byte{0x60, 0x01}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	s := NewState(storage)
+	require.NoError(t, s.SetCode(codeHash, code))
+
+	objs := make([]*state.Object, 0, 50)
+
+	for i := 1; i <= 50; i++ {
+		objs = append(objs, &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i)).Bytes()),
+			Balance:  big.NewInt(int64(i)),
+			CodeHash: codeHash,
+			Root:     types.EmptyRootHash,
+			Storage: []*state.StorageObject{
+				{Key: types.StringToHash("0x1").Bytes(), Val: big.NewInt(int64(i)).Bytes()},
+				{Key: types.StringToHash("0x2").Bytes(), Val: big.NewInt(int64(i)).Bytes()},
+			},
+		})
+	}
+
+	_, root, err := s.NewSnapshot().Commit(objs)
+	require.NoError(t, err)
+
+	return types.BytesToHash(root)
+}
+
+// deliverStateData serves the missing state data of the sync from the source storage,
+// stopping after the given number of rounds (or when the sync is complete if it's negative).
+// It returns the number of the delivered trie nodes and contract codes
+func deliverStateData(t *testing.T, sync *StateSync, source Storage, rounds int) int {
+	t.Helper()
+
+	delivered := 0
+
+	for i := 0; sync.Pending() > 0 && i != rounds; i++ {
+		nodes, codes := sync.Missing(16, 16)
+
+		for _, hash := range nodes {
+			data, ok, err := source.Get(hash.Bytes())
+			require.NoError(t, err)
+			require.True(t, ok)
+
+			require.NoError(t, sync.ProcessNode(data))
+		}
+
+		for _, hash := range codes {
+			code, ok := source.GetCode(hash)
+			require.True(t, ok)
+
+			require.NoError(t, sync.ProcessCode(code))
+		}
+
+		delivered += len(nodes) + len(codes)
+	}
+
+	return delivered
+}
+
+func TestStateSync(t *testing.T) {
+	t.Parallel()
+
+	source := NewMemoryStorage()
+	root := commitSyncTestState(t, source)
+
+	target := NewMemoryStorage()
+
+	sync, err := NewStateSync(target, root)
+	require.NoError(t, err)
+	require.Equal(t, 1, sync.Pending())
+
+	total := deliverStateData(t, sync, source, -1)
+	require.Greater(t, total, 100)
+
+	expected, err := markReachable(source, []types.Hash{root})
+	require.NoError(t, err)
+
+	actual, err := markReachable(target, []types.Hash{root})
+	require.NoError(t, err)
+	require.Equal(t, expected, actual)
+
+	snap, err := NewState(target).NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(7).Bytes()))
+	require.NoError(t, err)
+	require.Equal(t, big.NewInt(7), account.Balance)
+	require.Equal(t,
+		types.BytesToHash(big.NewInt(7).Bytes()),
+		snap.GetStorage(types.BytesToAddress(big.NewInt(7).Bytes()), account.Root, types.StringToHash("0x2")),
+	)
+}
+
+func TestStateSync_Resume(t *testing.T) {
+	t.Parallel()
+
+	source := NewMemoryStorage()
+	root := commitSyncTestState(t, source)
+
+	target := NewMemoryStorage()
+
+	// interrupt the sync after a few rounds
+	sync, err := NewStateSync(target, root)
+	require.NoError(t, err)
+
+	interrupted := deliverStateData(t, sync, source, 3)
+	require.NotZero(t, sync.Pending())
+
+	// the root is not stored until the state is complete
+	_, ok, err := target.Get(root.Bytes())
+	require.NoError(t, err)
+	require.False(t, ok)
+
+	// the new sync requests only the root and the nodes which are still missing
+	sync, err = NewStateSync(target, root)
+	require.NoError(t, err)
+
+	resumed := deliverStateData(t, sync, source, -1)
+
+	fresh, err := NewStateSync(NewMemoryStorage(), root)
+	require.NoError(t, err)
+	require.Equal(t, deliverStateData(t, fresh, source, -1)+1, interrupted+resumed)
+
+	_, err = markReachable(target, []types.Hash{root})
+	require.NoError(t, err)
+
+	// nothing is requested once the state is complete
+	sync, err = NewStateSync(target, root)
+	require.NoError(t, err)
+	require.Zero(t, sync.Pending())
+}
+
+func TestStateSync_UnexpectedData(t *testing.T) {
+	t.Parallel()
+
+	source := NewMemoryStorage()
+	root := commitSyncTestState(t, source)
+
+	target := NewMemoryStorage()
+
+	sync, err := NewStateSync(target, root)
+	require.NoError(t, err)
+
+	require.ErrorIs(t, sync.ProcessNode([]byte{0xc0}), ErrUnexpectedStateData)
+	require.ErrorIs(t, sync.ProcessCode([]byte{0x60, 0x02}), ErrUnexpectedStateData)
+
+	_, ok, err := target.Get(crypto.Keccak256([]byte{0xc0}))
+	require.NoError(t, err)
+	require.False(t, ok)
+}
@@ -8,6 +8,7 @@ import (
 	"golang.org/x/crypto/sha3"
 
 	commonHelpers "github.com/0xPolygon/polygon-edge/helper/common"
+	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
@@ -99,11 +100,17 @@ func NewTrie() *Trie {
 	return &Trie{}
 }
 
-func (t *Trie) Get(k []byte, storage Storage) ([]byte, bool) {
+// Get returns the value of the key. It returns an error if a node on the path to the key
+// is missing from the storage (e.g. it has been pruned), rather than reporting the key as absent
+func (t *Trie) Get(k []byte, storage Storage) ([]byte, bool, error) {
 	txn := t.Txn(storage)
-	res := txn.Lookup(k)
 
-	return res, res != nil
+	res, err := txn.Lookup(k)
+	if err != nil {
+		return nil, false, err
+	}
+
+	return res, res != nil, nil
 }
 
 func hashit(k []byte) []byte {
@@ -154,16 +161,16 @@ func (t *Txn) Commit() *Trie {
 	return &Trie{epoch: t.epoch, root: t.root}
 }
 
-func (t *Txn) Lookup(key []byte) []byte {
-	_, res := t.lookup(t.root, bytesToHexNibbles(key))
+func (t *Txn) Lookup(key []byte) ([]byte, error) {
+	_, res, err := t.lookup(t.root, bytesToHexNibbles(key))
 
-	return res
+	return res, err
 }
 
-func (t *Txn) lookup(node interface{}, key []byte) (Node, []byte) {
+func (t *Txn) lookup(node interface{}, key []byte) (Node, []byte, error) {
 	switch n := node.(type) {
 	case nil:
-		return nil, nil
+		return nil, nil, nil
 
 	case *ValueNode:
 		if n.hash {
@@ -173,46 +180,46 @@ func (t *Txn) lookup(node interface{}, key []byte) (Node, []byte) {
 			}
 
 			if !ok {
-				return nil, nil
+				return nil, nil, fmt.Errorf("%w: trie node %s is missing", state.ErrStateNotAvailable, types.BytesToHash(n.buf))
 			}
 
-			_, res := t.lookup(nc, key)
+			_, res, err := t.lookup(nc, key)
 
-			return nc, res
+			return nc, res, err
 		}
 
 		if len(key) == 0 {
-			return nil, n.buf
+			return nil, n.buf, nil
 		} else {
-			return nil, nil
+			return nil, nil, nil
 		}
 
 	case *ShortNode:
 		plen := len(n.key)
 		if plen > len(key) || !bytes.Equal(key[:plen], n.key) {
-			return nil, nil
+			return nil, nil, nil
 		}
 
-		child, res := t.lookup(n.child, key[plen:])
+		child, res, err := t.lookup(n.child, key[plen:])
 
 		if child != nil {
 			n.child = child
 		}
 
-		return nil, res
+		return nil, res, err
 
 	case *FullNode:
 		if len(key) == 0 {
 			return t.lookup(n.value, key)
 		}
 
-		child, res := t.lookup(n.getEdge(key[0]), key[1:])
+		child, res, err := t.lookup(n.getEdge(key[0]), key[1:])
 
 		if child != nil {
 			n.children[key[0]] = child
 		}
 
-		return nil, res
+		return nil, res, err
 
 	default:
 		panic(fmt.Sprintf("unknown node type %v", n)) //nolint:gocritic
@@ -82,10 +82,13 @@ func init() {
 	register(MLOAD, handler{inst: opMLoad, stack: 1, gas: 3})
 	register(MSTORE, handler{inst: opMStore, stack: 2, gas: 3})
 	register(MSTORE8, handler{inst: opMStore8, stack: 2, gas: 3})
+	register(MCOPY, handler{inst: opMCopy, stack: 3, gas: 3})
 
 	// store
 	register(SLOAD, handler{inst: opSload, stack: 1, gas: 0})
 	register(SSTORE, handler{inst: opSStore, stack: 2, gas: 0})
+	register(TLOAD, handler{inst: opTload, stack: 1, gas: 100})
+	register(TSTORE, handler{inst: opTstore, stack: 2, gas: 100})
 
 	register(SHA3, handler{inst: opSha3, stack: 2, gas: 30})
 
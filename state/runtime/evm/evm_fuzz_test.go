@@ -146,6 +146,11 @@ func (m *mockHostF) ContainsAccessListSlot(addr types.Address, slot types.Hash)
 }
 func (m *mockHostF) DeleteAccessListAddress(addr types.Address)               {}
 func (m *mockHostF) DeleteAccessListSlot(addr types.Address, slot types.Hash) {}
+func (m *mockHostF) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	return types.ZeroHash
+}
+func (m *mockHostF) SetTransientState(addr types.Address, key types.Hash, value types.Hash)   {}
+func (m *mockHostF) RevertTransientState(addr types.Address, key types.Hash, prev types.Hash) {}
 
 func FuzzTestEVM(f *testing.F) {
 	seed := []byte{
@@ -29,8 +29,9 @@ func newMockContract(value *big.Int, gas uint64, code []byte) *runtime.Contract
 type mockHost struct {
 	mock.Mock
 
-	tracer     runtime.VMTracer
-	accessList *runtime.AccessList
+	tracer           runtime.VMTracer
+	accessList       *runtime.AccessList
+	transientStorage *runtime.TransientStorage
 }
 
 func (m *mockHost) AccountExists(addr types.Address) bool {
@@ -160,6 +161,18 @@ func (m *mockHost) DeleteAccessListSlot(addr types.Address, slot types.Hash) {
 	m.accessList.DeleteSlot(addr, slot)
 }
 
+func (m *mockHost) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	return m.transientStorage.Get(addr, key)
+}
+
+func (m *mockHost) SetTransientState(addr types.Address, key types.Hash, value types.Hash) {
+	m.transientStorage.Set(addr, key, value)
+}
+
+func (m *mockHost) RevertTransientState(addr types.Address, key types.Hash, prev types.Hash) {
+	m.transientStorage.Set(addr, key, prev)
+}
+
 func TestRun(t *testing.T) {
 	t.Parallel()
 
@@ -478,6 +478,40 @@ func opMStore8(c *state) {
 	c.memory[offset.Uint64()] = byte(val.Uint64() & 0xff)
 }
 
+func opMCopy(c *state) {
+	if !c.config.Cancun {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	dst := c.pop()
+	src := c.pop()
+	length := c.pop()
+
+	if length.Sign() == 0 {
+		return
+	}
+
+	// the memory is expanded to cover both the source and the destination areas
+	offset := dst
+	if src.Cmp(dst) > 0 {
+		offset = src
+	}
+
+	if !c.allocateMemory(offset, length) {
+		return
+	}
+
+	size := length.Uint64()
+	if !c.consumeGas(((size + 31) / 32) * copyGas) {
+		return
+	}
+
+	// the areas may overlap, copy behaves like memmove
+	copy(c.memory[dst.Uint64():dst.Uint64()+size], c.memory[src.Uint64():src.Uint64()+size])
+}
+
 // --- storage ---
 
 func opSload(c *state) {
@@ -585,6 +619,38 @@ func opSStore(c *state) {
 	}
 }
 
+func opTload(c *state) {
+	if !c.config.Cancun {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	loc := c.top()
+
+	val := c.host.GetTransientState(c.msg.Address, bigToHash(loc))
+	loc.SetBytes(val.Bytes())
+}
+
+func opTstore(c *state) {
+	if !c.config.Cancun {
+		c.exit(errOpCodeNotFound)
+
+		return
+	}
+
+	if c.inStaticCall() {
+		c.exit(errWriteProtection)
+
+		return
+	}
+
+	key := c.popHash()
+	val := c.popHash()
+
+	c.host.SetTransientState(c.msg.Address, key, val)
+}
+
 const sha3WordGas uint64 = 6
 
 func opSha3(c *state) {
@@ -1418,6 +1484,13 @@ func (c *state) buildCreateContract(op OpCode) (*runtime.Contract, error) {
 
 	// Calculate and consume gas cost
 
+	// EIP-3860: limit the init code
+	if c.config.Shanghai && (!length.IsUint64() || length.Uint64() > runtime.MaxInitCodeSize) {
+		c.exit(runtime.ErrMaxInitCodeSizeExceeded)
+
+		return nil, nil
+	}
+
 	// Both CREATE and CREATE2 use memory
 	var input []byte
 
@@ -1436,6 +1509,14 @@ func (c *state) buildCreateContract(op OpCode) (*runtime.Contract, error) {
 		}
 	}
 
+	// EIP-3860: meter the init code
+	if c.config.Shanghai {
+		size := length.Uint64()
+		if !c.consumeGas(((size + 31) / 32) * runtime.InitCodeWordGas) {
+			return nil, nil
+		}
+	}
+
 	if hasTransfer {
 		if c.host.GetBalance(c.msg.Address).Cmp(value) < 0 {
 			return nil, types.ErrInsufficientFunds
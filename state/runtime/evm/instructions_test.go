@@ -567,6 +567,128 @@ func TestMStore8(t *testing.T) {
 	assert.Equal(t, one, s.pop())
 }
 
+func TestMCopy(t *testing.T) {
+	word := new(big.Int).SetBytes([]byte{0x1, 0x2, 0x3})
+
+	t.Run("copies to the expanded memory", func(t *testing.T) {
+		s, closeFn := getState(&allEnabledForks)
+		defer closeFn()
+
+		s.push(word)
+		s.push(zero)
+		opMStore(s)
+
+		// mcopy(32, 0, 32)
+		s.push(big.NewInt(32))
+		s.push(zero)
+		s.push(big.NewInt(32))
+		opMCopy(s)
+
+		require.NoError(t, s.err)
+		require.Len(t, s.memory, 64)
+		require.Equal(t, s.memory[:32], s.memory[32:])
+
+		// the second memory word and a single copied word
+		require.Equal(t, defaultInitialGas-3-3-copyGas, s.gas)
+	})
+
+	t.Run("copies the overlapping areas", func(t *testing.T) {
+		s, closeFn := getState(&allEnabledForks)
+		defer closeFn()
+
+		s.push(word)
+		s.push(zero)
+		opMStore(s)
+
+		// mcopy(1, 0, 31)
+		s.push(big.NewInt(31))
+		s.push(zero)
+		s.push(one)
+		opMCopy(s)
+
+		require.NoError(t, s.err)
+		require.Equal(t, append([]byte{0x0}, word.FillBytes(make([]byte, 32))[:31]...), s.memory)
+	})
+
+	t.Run("zero length doesn't expand the memory", func(t *testing.T) {
+		s, closeFn := getState(&allEnabledForks)
+		defer closeFn()
+
+		s.push(zero)
+		s.push(big.NewInt(1024))
+		s.push(big.NewInt(2048))
+		opMCopy(s)
+
+		require.NoError(t, s.err)
+		require.Empty(t, s.memory)
+		require.Equal(t, defaultInitialGas, s.gas)
+	})
+
+	t.Run("Cancun disabled", func(t *testing.T) {
+		allExceptCancunFork := chain.AllForksEnabled.Copy().RemoveFork(chain.Cancun).At(0)
+
+		s, closeFn := getState(&allExceptCancunFork)
+		defer closeFn()
+
+		s.push(one)
+		s.push(zero)
+		s.push(zero)
+		opMCopy(s)
+
+		require.ErrorIs(t, s.err, errOpCodeNotFound)
+	})
+}
+
+func TestTransientStorage(t *testing.T) {
+	t.Run("stores and loads the value", func(t *testing.T) {
+		s, closeFn := getState(&allEnabledForks)
+		defer closeFn()
+
+		s.host = &mockHost{transientStorage: runtime.NewTransientStorage()}
+
+		s.push(two)
+		s.push(one)
+		opTstore(s)
+		require.NoError(t, s.err)
+
+		s.push(one)
+		opTload(s)
+		require.NoError(t, s.err)
+		require.Equal(t, two, s.pop())
+
+		// the values are kept per account
+		s.msg.Address = types.StringToAddress("0x1")
+
+		s.push(one)
+		opTload(s)
+		require.Equal(t, zero.Uint64(), s.pop().Uint64())
+	})
+
+	t.Run("write protection in static call", func(t *testing.T) {
+		s, closeFn := getState(&allEnabledForks)
+		defer closeFn()
+
+		s.host = &mockHost{transientStorage: runtime.NewTransientStorage()}
+		s.msg.Static = true
+
+		s.push(two)
+		s.push(one)
+		opTstore(s)
+		require.ErrorIs(t, s.err, errWriteProtection)
+	})
+
+	t.Run("Cancun disabled", func(t *testing.T) {
+		allExceptCancunFork := chain.AllForksEnabled.Copy().RemoveFork(chain.Cancun).At(0)
+
+		s, closeFn := getState(&allExceptCancunFork)
+		defer closeFn()
+
+		s.push(one)
+		opTload(s)
+		require.ErrorIs(t, s.err, errOpCodeNotFound)
+	})
+}
+
 func TestSload(t *testing.T) {
 	t.Run("Istanbul", func(t *testing.T) {
 		s, closeFn := getState(&chain.ForksInTime{Istanbul: true})
@@ -1951,6 +2073,90 @@ func TestCreate(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "should throw ErrMaxInitCodeSizeExceeded when the init code is too large and config.Shanghai is enabled",
+			op:   CREATE,
+			contract: &runtime.Contract{
+				Static:  false,
+				Address: addr1,
+			},
+			config: &chain.ForksInTime{
+				Shanghai: true,
+			},
+			initState: &state{
+				gas: 1000,
+				sp:  3,
+				stack: []*big.Int{
+					big.NewInt(runtime.MaxInitCodeSize + 1), // length
+					big.NewInt(0x00),                        // offset
+					big.NewInt(0x00),                        // value
+				},
+				memory: []byte{
+					byte(REVERT),
+				},
+			},
+			resultState: &state{
+				gas: 1000,
+				sp:  0,
+				stack: []*big.Int{
+					big.NewInt(runtime.MaxInitCodeSize + 1),
+					big.NewInt(0x00),
+					big.NewInt(0x00),
+				},
+				memory: []byte{
+					byte(REVERT),
+				},
+				stop: true,
+				err:  runtime.ErrMaxInitCodeSizeExceeded,
+			},
+			mockHost: &mockHostForInstructions{
+				mockHost: mockHost{
+					accessList: runtime.NewAccessList(),
+				},
+			},
+		},
+		{
+			name: "should throw errOutOfGas when the init code words gas can't be paid and config.Shanghai is enabled",
+			op:   CREATE,
+			contract: &runtime.Contract{
+				Static:  false,
+				Address: addr1,
+			},
+			config: &chain.ForksInTime{
+				Shanghai: true,
+			},
+			initState: &state{
+				gas: 1,
+				sp:  3,
+				stack: []*big.Int{
+					big.NewInt(0x01), // length
+					big.NewInt(0x00), // offset
+					big.NewInt(0x00), // value
+				},
+				memory: []byte{
+					byte(REVERT),
+				},
+			},
+			resultState: &state{
+				gas: 1,
+				sp:  0,
+				stack: []*big.Int{
+					big.NewInt(0x01),
+					big.NewInt(0x00),
+					big.NewInt(0x00),
+				},
+				memory: []byte{
+					byte(REVERT),
+				},
+				stop: true,
+				err:  errOutOfGas,
+			},
+			mockHost: &mockHostForInstructions{
+				mockHost: mockHost{
+					accessList: runtime.NewAccessList(),
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -200,6 +200,15 @@ const (
 	// JUMPDEST corresponds to a possible jump destination
 	JUMPDEST = 0x5B
 
+	// TLOAD loads a word from the transient storage
+	TLOAD = 0x5C
+
+	// TSTORE saves a word to the transient storage
+	TSTORE = 0x5D
+
+	// MCOPY copies an area of memory to another area of memory
+	MCOPY = 0x5E
+
 	// PUSH0 pushes a 0 constant onto the stack
 	PUSH0 = 0x5F
 
@@ -327,6 +336,9 @@ var opCodeToString = map[OpCode]string{
 	MSIZE:          "MSIZE",
 	GAS:            "GAS",
 	JUMPDEST:       "JUMPDEST",
+	TLOAD:          "TLOAD",
+	TSTORE:         "TSTORE",
+	MCOPY:          "MCOPY",
 	CREATE:         "CREATE",
 	CALL:           "CALL",
 	RETURN:         "RETURN",
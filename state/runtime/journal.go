@@ -42,6 +42,11 @@ type (
 		Address types.Address
 		Slot    types.Hash
 	}
+	TransientStorageChange struct {
+		Address types.Address
+		Key     types.Hash
+		Prev    types.Hash
+	}
 )
 
 var _ JournalEntry = (*AccessListAddAccountChange)(nil)
@@ -55,3 +60,9 @@ var _ JournalEntry = (*AccessListAddSlotChange)(nil)
 func (ch AccessListAddSlotChange) Revert(host Host) {
 	host.DeleteAccessListSlot(ch.Address, ch.Slot)
 }
+
+var _ JournalEntry = (*TransientStorageChange)(nil)
+
+func (ch TransientStorageChange) Revert(host Host) {
+	host.RevertTransientState(ch.Address, ch.Key, ch.Prev)
+}
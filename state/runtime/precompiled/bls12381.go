@@ -0,0 +1,415 @@
+package precompiled
+
+import (
+	"errors"
+
+	"github.com/consensys/gnark-crypto/ecc"
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fp"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// bls12381FieldLength is the length of the encoded base field element,
+	// which is the 48 bytes element left padded with zeros
+	bls12381FieldLength = 64
+
+	// bls12381FieldPadding is the length of the zero padding of the 48 bytes base field element
+	bls12381FieldPadding = bls12381FieldLength - fp.Bytes
+
+	// bls12381G1PointLength and bls12381G2PointLength are the lengths of the encoded points
+	bls12381G1PointLength = 2 * bls12381FieldLength
+	bls12381G2PointLength = 4 * bls12381FieldLength
+
+	bls12381ScalarLength = 32
+
+	bls12381G1AddGas          = 375
+	bls12381G2AddGas          = 600
+	bls12381G1MulGas          = 12000
+	bls12381G2MulGas          = 22500
+	bls12381PairingBaseGas    = 37700
+	bls12381PairingPerPairGas = 32600
+	bls12381MapG1Gas          = 5500
+	bls12381MapG2Gas          = 23800
+
+	// bls12381MSMMultiplier is the divisor of the MSM discounts
+	bls12381MSMMultiplier = 1000
+)
+
+var (
+	errBLS12381InvalidInputLength  = errors.New("invalid input length")
+	errBLS12381InvalidFieldPadding = errors.New("invalid field element padding")
+	errBLS12381NotOnCurve          = errors.New("point is not on the curve")
+	errBLS12381NotInSubgroup       = errors.New("point is not in the correct subgroup")
+)
+
+// bls12381G1MSMDiscounts and bls12381G2MSMDiscounts are the MSM discounts per the number of pairs,
+// the last discount applies to all the greater numbers of pairs
+var (
+	bls12381G1MSMDiscounts = []uint64{
+		1000, 949, 848, 797, 764, 750, 738, 728, 719, 712, 705, 698, 692, 687, 682, 677, 673, 669, 665, 661,
+		658, 654, 651, 648, 645, 642, 640, 637, 635, 632, 630, 627, 625, 623, 621, 619, 617, 615, 613, 611,
+		609, 608, 606, 604, 603, 601, 599, 598, 596, 595, 593, 592, 591, 589, 588, 586, 585, 584, 582, 581,
+		580, 579, 577, 576, 575, 574, 573, 572, 570, 569, 568, 567, 566, 565, 564, 563, 562, 561, 560, 559,
+		558, 557, 556, 555, 554, 553, 552, 551, 550, 549, 548, 547, 547, 546, 545, 544, 543, 542, 541, 540,
+		540, 539, 538, 537, 536, 536, 535, 534, 533, 532, 532, 531, 530, 529, 528, 528, 527, 526, 525, 525,
+		524, 523, 522, 522, 521, 520, 520, 519,
+	}
+
+	bls12381G2MSMDiscounts = []uint64{
+		1000, 1000, 923, 884, 855, 832, 812, 796, 782, 770, 759, 749, 740, 732, 724, 717, 711, 704, 699, 693,
+		688, 683, 679, 674, 670, 666, 663, 659, 655, 652, 649, 646, 643, 640, 637, 634, 632, 629, 627, 624,
+		622, 620, 618, 615, 613, 611, 609, 607, 606, 604, 602, 600, 598, 597, 595, 593, 592, 590, 589, 587,
+		586, 584, 583, 582, 580, 579, 578, 576, 575, 574, 573, 571, 570, 569, 568, 567, 566, 565, 563, 562,
+		561, 560, 559, 558, 557, 556, 555, 554, 553, 552, 552, 551, 550, 549, 548, 547, 546, 545, 545, 544,
+		543, 542, 541, 541, 540, 539, 538, 537, 537, 536, 535, 535, 534, 533, 532, 532, 531, 530, 530, 529,
+		528, 528, 527, 526, 526, 525, 524, 524,
+	}
+)
+
+// bls12381MSMGas returns the gas of the multi scalar multiplication of the given number of pairs
+func bls12381MSMGas(pairs int, mulGas uint64, discounts []uint64) uint64 {
+	if pairs == 0 {
+		return 0
+	}
+
+	discount := discounts[len(discounts)-1]
+	if pairs <= len(discounts) {
+		discount = discounts[pairs-1]
+	}
+
+	return uint64(pairs) * mulGas * discount / bls12381MSMMultiplier
+}
+
+// bls12381G1Add adds two G1 points (EIP-2537)
+type bls12381G1Add struct{}
+
+func (b *bls12381G1Add) gas(_ []byte, _ *chain.ForksInTime) uint64 {
+	return bls12381G1AddGas
+}
+
+func (b *bls12381G1Add) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	if len(input) != 2*bls12381G1PointLength {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	// the points are only checked to be on the curve, not to be in the subgroup
+	p0, err := decodeBLS12381G1Point(input[:bls12381G1PointLength], false)
+	if err != nil {
+		return nil, err
+	}
+
+	p1, err := decodeBLS12381G1Point(input[bls12381G1PointLength:], false)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeBLS12381G1Point(p0.Add(p0, p1)), nil
+}
+
+// bls12381G1MSM calculates the multi scalar multiplication of the G1 points (EIP-2537)
+type bls12381G1MSM struct{}
+
+func (b *bls12381G1MSM) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	pairs := len(input) / (bls12381G1PointLength + bls12381ScalarLength)
+
+	return bls12381MSMGas(pairs, bls12381G1MulGas, bls12381G1MSMDiscounts)
+}
+
+func (b *bls12381G1MSM) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	pairLength := bls12381G1PointLength + bls12381ScalarLength
+	if len(input) == 0 || len(input)%pairLength != 0 {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	var (
+		pairs   = len(input) / pairLength
+		points  = make([]bls12381.G1Affine, pairs)
+		scalars = make([]fr.Element, pairs)
+	)
+
+	for i := 0; i < pairs; i++ {
+		pair := input[i*pairLength : (i+1)*pairLength]
+
+		p, err := decodeBLS12381G1Point(pair[:bls12381G1PointLength], true)
+		if err != nil {
+			return nil, err
+		}
+
+		// the scalar is reduced modulo the subgroup order, which doesn't change the product
+		points[i] = *p
+		scalars[i].SetBytes(pair[bls12381G1PointLength:])
+	}
+
+	r, err := new(bls12381.G1Affine).MultiExp(points, scalars, ecc.MultiExpConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeBLS12381G1Point(r), nil
+}
+
+// bls12381G2Add adds two G2 points (EIP-2537)
+type bls12381G2Add struct{}
+
+func (b *bls12381G2Add) gas(_ []byte, _ *chain.ForksInTime) uint64 {
+	return bls12381G2AddGas
+}
+
+func (b *bls12381G2Add) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	if len(input) != 2*bls12381G2PointLength {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	// the points are only checked to be on the curve, not to be in the subgroup
+	p0, err := decodeBLS12381G2Point(input[:bls12381G2PointLength], false)
+	if err != nil {
+		return nil, err
+	}
+
+	p1, err := decodeBLS12381G2Point(input[bls12381G2PointLength:], false)
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeBLS12381G2Point(p0.Add(p0, p1)), nil
+}
+
+// bls12381G2MSM calculates the multi scalar multiplication of the G2 points (EIP-2537)
+type bls12381G2MSM struct{}
+
+func (b *bls12381G2MSM) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	pairs := len(input) / (bls12381G2PointLength + bls12381ScalarLength)
+
+	return bls12381MSMGas(pairs, bls12381G2MulGas, bls12381G2MSMDiscounts)
+}
+
+func (b *bls12381G2MSM) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	pairLength := bls12381G2PointLength + bls12381ScalarLength
+	if len(input) == 0 || len(input)%pairLength != 0 {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	var (
+		pairs   = len(input) / pairLength
+		points  = make([]bls12381.G2Affine, pairs)
+		scalars = make([]fr.Element, pairs)
+	)
+
+	for i := 0; i < pairs; i++ {
+		pair := input[i*pairLength : (i+1)*pairLength]
+
+		p, err := decodeBLS12381G2Point(pair[:bls12381G2PointLength], true)
+		if err != nil {
+			return nil, err
+		}
+
+		// the scalar is reduced modulo the subgroup order, which doesn't change the product
+		points[i] = *p
+		scalars[i].SetBytes(pair[bls12381G2PointLength:])
+	}
+
+	r, err := new(bls12381.G2Affine).MultiExp(points, scalars, ecc.MultiExpConfig{})
+	if err != nil {
+		return nil, err
+	}
+
+	return encodeBLS12381G2Point(r), nil
+}
+
+// bls12381Pairing checks that the product of the pairings of the G1 and G2 points is one (EIP-2537)
+type bls12381Pairing struct{}
+
+func (b *bls12381Pairing) gas(input []byte, _ *chain.ForksInTime) uint64 {
+	pairs := len(input) / (bls12381G1PointLength + bls12381G2PointLength)
+
+	return bls12381PairingBaseGas + bls12381PairingPerPairGas*uint64(pairs)
+}
+
+func (b *bls12381Pairing) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	pairLength := bls12381G1PointLength + bls12381G2PointLength
+	if len(input) == 0 || len(input)%pairLength != 0 {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	var (
+		pairs = len(input) / pairLength
+		g1    = make([]bls12381.G1Affine, pairs)
+		g2    = make([]bls12381.G2Affine, pairs)
+	)
+
+	for i := 0; i < pairs; i++ {
+		pair := input[i*pairLength : (i+1)*pairLength]
+
+		p1, err := decodeBLS12381G1Point(pair[:bls12381G1PointLength], true)
+		if err != nil {
+			return nil, err
+		}
+
+		p2, err := decodeBLS12381G2Point(pair[bls12381G1PointLength:], true)
+		if err != nil {
+			return nil, err
+		}
+
+		g1[i], g2[i] = *p1, *p2
+	}
+
+	// the pairs with a point at infinity don't change the product
+	if ok, err := bls12381.PairingCheck(g1, g2); err == nil && ok {
+		return abiBoolTrue, nil
+	}
+
+	return abiBoolFalse, nil
+}
+
+// bls12381MapG1 maps a base field element to a G1 point (EIP-2537)
+type bls12381MapG1 struct{}
+
+func (b *bls12381MapG1) gas(_ []byte, _ *chain.ForksInTime) uint64 {
+	return bls12381MapG1Gas
+}
+
+func (b *bls12381MapG1) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	if len(input) != bls12381FieldLength {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	fe, err := decodeBLS12381Field(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := bls12381.MapToG1(fe)
+
+	return encodeBLS12381G1Point(&p), nil
+}
+
+// bls12381MapG2 maps a quadratic extension field element to a G2 point (EIP-2537)
+type bls12381MapG2 struct{}
+
+func (b *bls12381MapG2) gas(_ []byte, _ *chain.ForksInTime) uint64 {
+	return bls12381MapG2Gas
+}
+
+func (b *bls12381MapG2) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	if len(input) != 2*bls12381FieldLength {
+		return nil, errBLS12381InvalidInputLength
+	}
+
+	fe, err := decodeBLS12381Field2(input)
+	if err != nil {
+		return nil, err
+	}
+
+	p := bls12381.MapToG2(fe)
+
+	return encodeBLS12381G2Point(&p), nil
+}
+
+// decodeBLS12381Field decodes the zero padded base field element,
+// which is checked to be less than the modulus
+func decodeBLS12381Field(input []byte) (fp.Element, error) {
+	for _, b := range input[:bls12381FieldPadding] {
+		if b != 0 {
+			return fp.Element{}, errBLS12381InvalidFieldPadding
+		}
+	}
+
+	return fp.BigEndian.Element((*[fp.Bytes]byte)(input[bls12381FieldPadding:bls12381FieldLength]))
+}
+
+// decodeBLS12381Field2 decodes the quadratic extension field element encoded as c0 || c1
+func decodeBLS12381Field2(input []byte) (bls12381.E2, error) {
+	c0, err := decodeBLS12381Field(input[:bls12381FieldLength])
+	if err != nil {
+		return bls12381.E2{}, err
+	}
+
+	c1, err := decodeBLS12381Field(input[bls12381FieldLength:])
+	if err != nil {
+		return bls12381.E2{}, err
+	}
+
+	return bls12381.E2{A0: c0, A1: c1}, nil
+}
+
+// decodeBLS12381G1Point decodes the G1 point encoded as x || y, where (0, 0) is the point at infinity.
+// The point is checked to be on the curve, and to be in the subgroup if requested
+func decodeBLS12381G1Point(input []byte, subgroupCheck bool) (*bls12381.G1Affine, error) {
+	x, err := decodeBLS12381Field(input[:bls12381FieldLength])
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := decodeBLS12381Field(input[bls12381FieldLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	p := &bls12381.G1Affine{X: x, Y: y}
+	if !p.IsOnCurve() {
+		return nil, errBLS12381NotOnCurve
+	}
+
+	if subgroupCheck && !p.IsInSubGroup() {
+		return nil, errBLS12381NotInSubgroup
+	}
+
+	return p, nil
+}
+
+// decodeBLS12381G2Point decodes the G2 point encoded as x || y, where (0, 0) is the point at infinity.
+// The point is checked to be on the curve, and to be in the subgroup if requested
+func decodeBLS12381G2Point(input []byte, subgroupCheck bool) (*bls12381.G2Affine, error) {
+	x, err := decodeBLS12381Field2(input[:2*bls12381FieldLength])
+	if err != nil {
+		return nil, err
+	}
+
+	y, err := decodeBLS12381Field2(input[2*bls12381FieldLength:])
+	if err != nil {
+		return nil, err
+	}
+
+	p := &bls12381.G2Affine{X: x, Y: y}
+	if !p.IsOnCurve() {
+		return nil, errBLS12381NotOnCurve
+	}
+
+	if subgroupCheck && !p.IsInSubGroup() {
+		return nil, errBLS12381NotInSubgroup
+	}
+
+	return p, nil
+}
+
+// encodeBLS12381G1Point encodes the G1 point as x || y, with the zero padded base field elements
+func encodeBLS12381G1Point(p *bls12381.G1Affine) []byte {
+	out := make([]byte, bls12381G1PointLength)
+
+	encodeBLS12381Field(out, p.X)
+	encodeBLS12381Field(out[bls12381FieldLength:], p.Y)
+
+	return out
+}
+
+// encodeBLS12381G2Point encodes the G2 point as x || y, with the quadratic extension field elements
+// encoded as c0 || c1 and the zero padded base field elements
+func encodeBLS12381G2Point(p *bls12381.G2Affine) []byte {
+	out := make([]byte, bls12381G2PointLength)
+
+	for i, fe := range []fp.Element{p.X.A0, p.X.A1, p.Y.A0, p.Y.A1} {
+		encodeBLS12381Field(out[i*bls12381FieldLength:], fe)
+	}
+
+	return out
+}
+
+// encodeBLS12381Field writes the zero padded base field element at the start of the given buffer
+func encodeBLS12381Field(out []byte, fe fp.Element) {
+	fp.BigEndian.PutElement((*[fp.Bytes]byte)(out[bls12381FieldPadding:bls12381FieldLength]), fe)
+}
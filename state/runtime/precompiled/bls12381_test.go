@@ -0,0 +1,82 @@
+package precompiled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TestBLS12381 runs the EIP-2537 vectors, as shipped by go-ethereum.
+// The single pair multiplication vectors run against the MSM precompiles
+func TestBLS12381(t *testing.T) {
+	t.Parallel()
+
+	fixtures := map[string]contract{
+		"blsG1Add.json":      &bls12381G1Add{},
+		"blsG1Mul.json":      &bls12381G1MSM{},
+		"blsG1MultiExp.json": &bls12381G1MSM{},
+		"blsG2Add.json":      &bls12381G2Add{},
+		"blsG2Mul.json":      &bls12381G2MSM{},
+		"blsG2MultiExp.json": &bls12381G2MSM{},
+		"blsPairing.json":    &bls12381Pairing{},
+		"blsMapG1.json":      &bls12381MapG1{},
+		"blsMapG2.json":      &bls12381MapG2{},
+	}
+
+	for file, p := range fixtures {
+		file, p := file, p
+
+		t.Run(file, func(t *testing.T) {
+			ReadTestCase(t, file, func(t *testing.T, c *TestCase) {
+				t.Helper()
+
+				require.Equal(t, c.Gas, p.gas(c.Input, nil))
+
+				out, err := p.run(c.Input, types.ZeroAddress, nil)
+				require.NoError(t, err)
+				require.Equal(t, c.Expected, out)
+			})
+		})
+
+		// the invalid inputs fail the call
+		t.Run("fail-"+file, func(t *testing.T) {
+			ReadTestCase(t, "fail-"+file, func(t *testing.T, c *TestCase) {
+				t.Helper()
+
+				_, err := p.run(c.Input, types.ZeroAddress, nil)
+				require.Error(t, err)
+			})
+		})
+	}
+}
+
+func TestBLS12381MSMGas(t *testing.T) {
+	t.Parallel()
+
+	// the discount is capped at the last entry of the table
+	require.Equal(t, uint64(200*12000*519/1000), bls12381MSMGas(200, bls12381G1MulGas, bls12381G1MSMDiscounts))
+	require.Equal(t, uint64(128*22500*524/1000), bls12381MSMGas(128, bls12381G2MulGas, bls12381G2MSMDiscounts))
+}
+
+func TestBLS12381Fork(t *testing.T) {
+	t.Parallel()
+
+	p := NewPrecompiled()
+
+	enabled := chain.AllForksEnabled.At(0)
+	disabled := chain.AllForksEnabled.Copy().RemoveFork(chain.EIP2537).At(0)
+
+	for _, addr := range []string{"0x0b", "0x0c", "0x0d", "0x0e", "0x0f", "0x10", "0x11"} {
+		contract := &runtime.Contract{CodeAddress: types.StringToAddress(addr)}
+
+		require.True(t, p.CanRun(contract, nil, &enabled), addr)
+		require.False(t, p.CanRun(contract, nil, &disabled), addr)
+
+		require.Contains(t, p.ActiveAddrs(&enabled), contract.CodeAddress, addr)
+		require.NotContains(t, p.ActiveAddrs(&disabled), contract.CodeAddress, addr)
+	}
+}
@@ -199,6 +199,20 @@ func (d *dummyHost) DeleteAccessListSlot(addr types.Address, slot types.Hash) {
 	d.t.Fatalf("DeleteAccessListSlot is not implemented")
 }
 
+func (d *dummyHost) GetTransientState(addr types.Address, key types.Hash) types.Hash {
+	d.t.Fatalf("GetTransientState is not implemented")
+
+	return types.ZeroHash
+}
+
+func (d *dummyHost) SetTransientState(addr types.Address, key types.Hash, value types.Hash) {
+	d.t.Fatalf("SetTransientState is not implemented")
+}
+
+func (d *dummyHost) RevertTransientState(addr types.Address, key types.Hash, prev types.Hash) {
+	d.t.Fatalf("RevertTransientState is not implemented")
+}
+
 func (d dummyHost) Transfer(from types.Address, to types.Address, amount *big.Int) error {
 	if d.balances == nil {
 		d.balances = map[types.Address]*big.Int{}
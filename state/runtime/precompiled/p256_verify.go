@@ -0,0 +1,49 @@
+package precompiled
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// p256VerifyInputLength is the length of the P256VERIFY input:
+	// the message hash, the signature r and s, and the public key x and y (32 bytes each)
+	p256VerifyInputLength = 160
+
+	p256VerifyGas = 3450
+)
+
+// p256Verify verifies the secp256r1 (P-256) signatures (RIP-7212)
+type p256Verify struct{}
+
+func (p *p256Verify) gas(_ []byte, _ *chain.ForksInTime) uint64 {
+	return p256VerifyGas
+}
+
+// run returns 1 as a 32 byte word if the signature is valid, and no output otherwise
+func (p *p256Verify) run(input []byte, _ types.Address, _ runtime.Host) ([]byte, error) {
+	if len(input) != p256VerifyInputLength {
+		return nil, nil
+	}
+
+	var (
+		hash = input[:32]
+		r    = new(big.Int).SetBytes(input[32:64])
+		s    = new(big.Int).SetBytes(input[64:96])
+		x    = new(big.Int).SetBytes(input[96:128])
+		y    = new(big.Int).SetBytes(input[128:160])
+	)
+
+	// the signature values out of range and the public keys which are not on the curve
+	// (including the point at infinity) are rejected by the verification
+	if !ecdsa.Verify(&ecdsa.PublicKey{Curve: elliptic.P256(), X: x, Y: y}, hash, r, s) {
+		return nil, nil
+	}
+
+	return abiBoolTrue, nil
+}
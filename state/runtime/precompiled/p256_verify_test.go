@@ -0,0 +1,52 @@
+package precompiled
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/chain"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TestP256Verify runs the Wycheproof ecdsa_secp256r1_sha256 vectors, along with the RIP-7212 reference ones
+func TestP256Verify(t *testing.T) {
+	t.Parallel()
+
+	p := &p256Verify{}
+
+	// ReadTestCase runs the fixture and each of its cases in parallel
+	t.Run("p256Verify.json", func(t *testing.T) {
+		ReadTestCase(t, "p256Verify.json", func(t *testing.T, c *TestCase) {
+			t.Helper()
+
+			require.Equal(t, c.Gas, p.gas(c.Input, nil))
+
+			out, err := p.run(c.Input, types.ZeroAddress, nil)
+			require.NoError(t, err)
+
+			// the invalid signatures don't return any output
+			if len(c.Expected) == 0 {
+				require.Empty(t, out)
+			} else {
+				require.Equal(t, c.Expected, out)
+			}
+		})
+	})
+}
+
+func TestP256VerifyFork(t *testing.T) {
+	t.Parallel()
+
+	p := NewPrecompiled()
+	contract := &runtime.Contract{CodeAddress: types.StringToAddress("0x100")}
+
+	enabled := chain.AllForksEnabled.At(0)
+	require.True(t, p.CanRun(contract, nil, &enabled))
+	require.Contains(t, p.ActiveAddrs(&enabled), contract.CodeAddress)
+
+	disabled := chain.AllForksEnabled.Copy().RemoveFork(chain.RIP7212).At(0)
+	require.False(t, p.CanRun(contract, nil, &disabled))
+	require.NotContains(t, p.ActiveAddrs(&disabled), contract.CodeAddress)
+}
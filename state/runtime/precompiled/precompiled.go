@@ -71,6 +71,18 @@ func (p *Precompiled) setupContracts() {
 	// Istanbul fork
 	p.register("9", &blake2f{p})
 
+	// RIP-7212 fork
+	p.register(p256VerifyAddr.String(), &p256Verify{})
+
+	// EIP-2537 fork
+	p.register(bls12381G1AddAddr.String(), &bls12381G1Add{})
+	p.register(bls12381G1MSMAddr.String(), &bls12381G1MSM{})
+	p.register(bls12381G2AddAddr.String(), &bls12381G2Add{})
+	p.register(bls12381G2MSMAddr.String(), &bls12381G2MSM{})
+	p.register(bls12381PairingAddr.String(), &bls12381Pairing{})
+	p.register(bls12381MapG1Addr.String(), &bls12381MapG1{})
+	p.register(bls12381MapG2Addr.String(), &bls12381MapG2{})
+
 	// Native transfer precompile
 	p.register(contracts.NativeTransferPrecompile.String(), &nativeTransfer{})
 
@@ -97,16 +109,45 @@ var (
 	seven = types.StringToAddress("7")
 	eight = types.StringToAddress("8")
 	nine  = types.StringToAddress("9")
+
+	p256VerifyAddr = types.StringToAddress("100")
+
+	bls12381G1AddAddr   = types.StringToAddress("b")
+	bls12381G1MSMAddr   = types.StringToAddress("c")
+	bls12381G2AddAddr   = types.StringToAddress("d")
+	bls12381G2MSMAddr   = types.StringToAddress("e")
+	bls12381PairingAddr = types.StringToAddress("f")
+	bls12381MapG1Addr   = types.StringToAddress("10")
+	bls12381MapG2Addr   = types.StringToAddress("11")
 )
 
 // CanRun implements the runtime interface
 func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.ForksInTime) bool {
-	if _, ok := p.contracts[c.CodeAddress]; !ok {
+	return p.isActive(c.CodeAddress, config)
+}
+
+// ActiveAddrs returns the addresses of the precompiles enabled by the given forks,
+// which are the ones warm at the start of a transaction
+func (p *Precompiled) ActiveAddrs(config *chain.ForksInTime) []types.Address {
+	addrs := make([]types.Address, 0, len(p.Addrs))
+
+	for _, addr := range p.Addrs {
+		if p.isActive(addr, config) {
+			addrs = append(addrs, addr)
+		}
+	}
+
+	return addrs
+}
+
+// isActive returns true if the precompile at the given address is enabled by the given forks
+func (p *Precompiled) isActive(addr types.Address, config *chain.ForksInTime) bool {
+	if _, ok := p.contracts[addr]; !ok {
 		return false
 	}
 
 	// byzantium precompiles
-	switch c.CodeAddress {
+	switch addr {
 	case five:
 		fallthrough
 	case six:
@@ -118,11 +159,23 @@ func (p *Precompiled) CanRun(c *runtime.Contract, _ runtime.Host, config *chain.
 	}
 
 	// istanbul precompiles
-	switch c.CodeAddress {
+	switch addr {
 	case nine:
 		return config.Istanbul
 	}
 
+	// secp256r1 signature verification precompile
+	if addr == p256VerifyAddr {
+		return config.RIP7212
+	}
+
+	// BLS12-381 precompiles
+	switch addr {
+	case bls12381G1AddAddr, bls12381G1MSMAddr, bls12381G2AddAddr, bls12381G2MSMAddr,
+		bls12381PairingAddr, bls12381MapG1Addr, bls12381MapG2Addr:
+		return config.EIP2537
+	}
+
 	return true
 }
 
@@ -86,6 +86,9 @@ type Host interface {
 	ContainsAccessListSlot(addr types.Address, slot types.Hash) (bool, bool)
 	DeleteAccessListAddress(addr types.Address)
 	DeleteAccessListSlot(addr types.Address, slot types.Hash)
+	GetTransientState(addr types.Address, key types.Hash) types.Hash
+	SetTransientState(addr types.Address, key types.Hash, value types.Hash)
+	RevertTransientState(addr types.Address, key types.Hash, prev types.Hash)
 }
 
 type VMTracer interface {
@@ -137,11 +140,20 @@ func (r *ExecutionResult) UpdateGasUsed(gasLimit uint64, refund, refundQuotient
 	r.GasUsed -= refund
 }
 
+const (
+	// MaxInitCodeSize is the maximum size of the contract creation init code (EIP-3860)
+	MaxInitCodeSize = 49152
+
+	// InitCodeWordGas is the gas charged per word of the contract creation init code (EIP-3860)
+	InitCodeWordGas uint64 = 2
+)
+
 var (
 	ErrOutOfGas                 = errors.New("out of gas")
 	ErrNotEnoughFunds           = errors.New("not enough funds")
 	ErrInsufficientBalance      = errors.New("insufficient balance for transfer")
 	ErrMaxCodeSizeExceeded      = errors.New("max code size exceeded")
+	ErrMaxInitCodeSizeExceeded  = errors.New("max initcode size exceeded")
 	ErrContractAddressCollision = errors.New("contract address collision")
 	ErrDepth                    = errors.New("max call depth exceeded")
 	ErrExecutionReverted        = errors.New("execution reverted")
@@ -0,0 +1,199 @@
+package accesslisttracer
+
+import (
+	"bytes"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// AccessListTracer collects all the addresses and storage slots touched by a transaction.
+// The sender, the receiver and the precompiled contracts are always warm,
+// so they are only listed if some of their storage slots are accessed
+type AccessListTracer struct {
+	list     *runtime.AccessList
+	excluded map[types.Address]struct{}
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewAccessListTracer creates a new tracer initialized with the given access list.
+// Addresses in the excluded list are never added to the collected access list
+func NewAccessListTracer(acl types.TxAccessList, excluded ...types.Address) *AccessListTracer {
+	t := &AccessListTracer{
+		list:     runtime.NewAccessList(),
+		excluded: make(map[types.Address]struct{}, len(excluded)),
+	}
+
+	for _, addr := range excluded {
+		t.excluded[addr] = struct{}{}
+	}
+
+	for _, tuple := range acl {
+		if _, ok := t.excluded[tuple.Address]; ok {
+			continue
+		}
+
+		t.list.AddAddress(tuple.Address)
+		t.list.AddSlot(tuple.Address, tuple.StorageKeys...)
+	}
+
+	return t
+}
+
+func (a *AccessListTracer) Cancel(err error) {
+	a.cancelLock.Lock()
+	defer a.cancelLock.Unlock()
+
+	a.reason = err
+	a.stop = true
+}
+
+func (a *AccessListTracer) cancelled() bool {
+	a.cancelLock.RLock()
+	defer a.cancelLock.RUnlock()
+
+	return a.stop
+}
+
+func (a *AccessListTracer) Clear() {
+	a.list = runtime.NewAccessList()
+}
+
+// GetResult returns the collected access list
+func (a *AccessListTracer) GetResult() (interface{}, error) {
+	a.cancelLock.RLock()
+	defer a.cancelLock.RUnlock()
+
+	if a.reason != nil {
+		return nil, a.reason
+	}
+
+	return a.AccessList(), nil
+}
+
+// AccessList returns the collected access list sorted by address and slot
+func (a *AccessListTracer) AccessList() types.TxAccessList {
+	acl := make(types.TxAccessList, 0, len(*a.list))
+
+	for addr, slots := range *a.list {
+		tuple := types.AccessTuple{
+			Address:     addr,
+			StorageKeys: make([]types.Hash, 0, len(slots)),
+		}
+
+		for slot := range slots {
+			tuple.StorageKeys = append(tuple.StorageKeys, slot)
+		}
+
+		sort.Slice(tuple.StorageKeys, func(i, j int) bool {
+			return bytes.Compare(tuple.StorageKeys[i][:], tuple.StorageKeys[j][:]) < 0
+		})
+
+		acl = append(acl, tuple)
+	}
+
+	sort.Slice(acl, func(i, j int) bool {
+		return bytes.Compare(acl[i].Address[:], acl[j].Address[:]) < 0
+	})
+
+	return acl
+}
+
+// Equal returns true if both tracers collected the same access list
+func (a *AccessListTracer) Equal(other *AccessListTracer) bool {
+	if len(*a.list) != len(*other.list) {
+		return false
+	}
+
+	for addr, slots := range *a.list {
+		otherSlots, ok := (*other.list)[addr]
+		if !ok || len(slots) != len(otherSlots) {
+			return false
+		}
+
+		for slot := range slots {
+			if _, ok := otherSlots[slot]; !ok {
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+func (a *AccessListTracer) TxStart(gasLimit uint64) {
+}
+
+func (a *AccessListTracer) TxEnd(gasLeft uint64) {
+}
+
+func (a *AccessListTracer) CallStart(depth int, from, to types.Address, callType int,
+	gas uint64, value *big.Int, input []byte) {
+	if depth == 1 {
+		// sender and receiver (or the created contract) are always warm
+		a.excluded[from] = struct{}{}
+		a.excluded[to] = struct{}{}
+
+		a.list.DeleteAddress(from)
+		a.list.DeleteAddress(to)
+	}
+}
+
+func (a *AccessListTracer) CallEnd(depth int, output []byte, err error) {
+}
+
+func (a *AccessListTracer) CaptureState(memory []byte, stack []*big.Int, opCode int,
+	contractAddress types.Address, sp int, host tracer.RuntimeHost, state tracer.VMState) {
+	if a.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	switch opCode {
+	case evm.SLOAD, evm.SSTORE:
+		if sp >= 1 {
+			a.addSlot(contractAddress, bigToHash(stack[sp-1]))
+		}
+
+	case evm.EXTCODECOPY, evm.EXTCODEHASH, evm.EXTCODESIZE, evm.BALANCE, evm.SELFDESTRUCT:
+		if sp >= 1 {
+			a.addAddress(types.BytesToAddress(stack[sp-1].Bytes()))
+		}
+
+	case evm.DELEGATECALL, evm.CALL, evm.STATICCALL, evm.CALLCODE:
+		if sp >= 5 {
+			a.addAddress(types.BytesToAddress(stack[sp-2].Bytes()))
+		}
+	}
+}
+
+func (a *AccessListTracer) ExecuteState(contractAddress types.Address, ip uint64, opcode string,
+	availableGas uint64, cost uint64, lastReturnData []byte, depth int, err error, host tracer.RuntimeHost) {
+}
+
+func (a *AccessListTracer) addAddress(addr types.Address) {
+	if _, ok := a.excluded[addr]; ok {
+		return
+	}
+
+	a.list.AddAddress(addr)
+}
+
+func (a *AccessListTracer) addSlot(addr types.Address, slot types.Hash) {
+	// slots are collected for the excluded addresses as well,
+	// because only the address itself is warm by default
+	a.list.AddSlot(addr, slot)
+}
+
+func bigToHash(b *big.Int) types.Hash {
+	return types.BytesToHash(b.Bytes())
+}
@@ -0,0 +1,115 @@
+package accesslisttracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockVMState struct {
+	halted bool
+}
+
+func (m *mockVMState) Halt() {
+	m.halted = true
+}
+
+func TestAccessListTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+	tracer := NewAccessListTracer(nil)
+
+	require.False(t, tracer.cancelled())
+
+	tracer.Cancel(err)
+
+	require.True(t, tracer.cancelled())
+
+	state := &mockVMState{}
+	tracer.CaptureState(nil, nil, evm.SLOAD, types.ZeroAddress, 0, nil, state)
+	require.True(t, state.halted)
+
+	res, resErr := tracer.GetResult()
+	require.Nil(t, res)
+	require.Equal(t, err, resErr)
+}
+
+func TestAccessListTracer_CollectsAccessList(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from       = types.StringToAddress("0x1")
+		to         = types.StringToAddress("0x2")
+		callee     = types.StringToAddress("0x3")
+		balanceOf  = types.StringToAddress("0x4")
+		precompile = types.StringToAddress("0x5")
+		slot1      = types.StringToHash("0x10")
+		slot2      = types.StringToHash("0x20")
+	)
+
+	tracer := NewAccessListTracer(
+		types.TxAccessList{
+			{Address: to, StorageKeys: []types.Hash{slot2}},
+			{Address: precompile},
+		},
+		precompile,
+	)
+
+	tracer.CallStart(1, from, to, 0, 100000, big.NewInt(0), nil)
+
+	state := &mockVMState{}
+
+	// SLOAD slot1 in the receiver
+	tracer.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(slot1.Bytes())}, evm.SLOAD, to, 1, nil, state)
+
+	// BALANCE of an external account and of a precompile
+	tracer.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(balanceOf.Bytes())}, evm.BALANCE, to, 1, nil, state)
+	tracer.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(precompile.Bytes())}, evm.BALANCE, to, 1, nil, state)
+
+	// CALL into another contract, address is the second element from the top of the stack
+	callStack := []*big.Int{
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0),
+		new(big.Int).SetBytes(callee.Bytes()), big.NewInt(1000),
+	}
+	tracer.CaptureState(nil, callStack, evm.CALL, to, len(callStack), nil, state)
+
+	// SSTORE slot2 in the callee
+	tracer.CaptureState(nil, []*big.Int{big.NewInt(1), new(big.Int).SetBytes(slot2.Bytes())}, evm.SSTORE, callee, 2, nil, state)
+
+	require.False(t, state.halted)
+
+	expected := types.TxAccessList{
+		{Address: to, StorageKeys: []types.Hash{slot1}},
+		{Address: callee, StorageKeys: []types.Hash{slot2}},
+		{Address: balanceOf, StorageKeys: []types.Hash{}},
+	}
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Equal(t, expected, res)
+}
+
+func TestAccessListTracer_Equal(t *testing.T) {
+	t.Parallel()
+
+	acl := types.TxAccessList{
+		{Address: types.StringToAddress("0x1"), StorageKeys: []types.Hash{types.StringToHash("0x1")}},
+	}
+
+	tracer1 := NewAccessListTracer(acl)
+	tracer2 := NewAccessListTracer(acl)
+
+	require.True(t, tracer1.Equal(tracer2))
+
+	tracer2.addSlot(types.StringToAddress("0x1"), types.StringToHash("0x2"))
+	require.False(t, tracer1.Equal(tracer2))
+
+	tracer1.Clear()
+	require.Empty(t, tracer1.AccessList())
+}
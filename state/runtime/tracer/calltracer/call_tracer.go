@@ -29,13 +29,23 @@ type Call struct {
 	GasUsed string  `json:"gasUsed"`
 	Input   string  `json:"input"`
 	Output  string  `json:"output"`
+	Error   string  `json:"error,omitempty"`
 	Calls   []*Call `json:"calls,omitempty"`
 
 	parent   *Call
 	startGas uint64
 }
 
+// Config is the configuration of the call tracer
+type Config struct {
+	// ContinueOnError makes the tracer record the errors of the failed calls
+	// in the call frames instead of cancelling the tracing
+	ContinueOnError bool
+}
+
 type CallTracer struct {
+	Config Config
+
 	call               *Call
 	activeCall         *Call
 	activeGas          uint64
@@ -46,6 +56,13 @@ type CallTracer struct {
 	stop       bool
 }
 
+// NewCallTracer creates a new call tracer with the given config
+func NewCallTracer(config Config) *CallTracer {
+	return &CallTracer{
+		Config: config,
+	}
+}
+
 func (c *CallTracer) Cancel(err error) {
 	c.cancelLock.Lock()
 	defer c.cancelLock.Unlock()
@@ -134,11 +151,15 @@ func (c *CallTracer) CallEnd(depth int, output []byte, err error) {
 	c.activeCall.GasUsed = hex.EncodeUint64(gasUsed)
 	c.activeGas = 0
 
+	if err != nil {
+		c.activeCall.Error = err.Error()
+	}
+
 	if depth > 1 {
 		c.activeCall = c.activeCall.parent
 	}
 
-	if err != nil {
+	if err != nil && !c.Config.ContinueOnError {
 		c.Cancel(err)
 	}
 }
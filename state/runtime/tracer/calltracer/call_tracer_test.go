@@ -285,4 +285,26 @@ func TestCallTracer_CallEnd(t *testing.T) {
 		require.Equal(t, "0x0", tracer.activeCall.GasUsed)
 		require.Equal(t, uint64(500), tracer.activeCall.startGas)
 	})
+	t.Run("call_end_with_error_continue_on_error", func(t *testing.T) {
+		t.Parallel()
+
+		parent := &Call{startGas: 2000}
+
+		tracer := NewCallTracer(Config{ContinueOnError: true})
+		tracer.activeAvailableGas = 500
+		tracer.activeCall = &Call{
+			startGas: 1000,
+			parent:   parent,
+		}
+
+		failedCall := tracer.activeCall
+
+		tracer.CallEnd(2, output, err)
+
+		require.False(t, tracer.stop)
+		require.NoError(t, tracer.reason)
+		require.Equal(t, err.Error(), failedCall.Error)
+		require.Equal(t, hex.EncodeUint64(500), failedCall.GasUsed)
+		require.Equal(t, parent, tracer.activeCall)
+	})
 }
@@ -0,0 +1,118 @@
+package fourbytetracer
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const selectorLength = 4
+
+// FourByteTracer counts the function selectors of all the calls made by a transaction,
+// together with the size of the call data following the selector.
+// The result is a map of "0x<selector>-<size>" keys to the number of occurrences
+type FourByteTracer struct {
+	ids         map[string]int
+	precompiles map[types.Address]struct{}
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewFourByteTracer creates a new 4byte tracer.
+// Calls to the given precompiled contracts are not counted
+func NewFourByteTracer(precompiles ...types.Address) *FourByteTracer {
+	t := &FourByteTracer{
+		ids:         make(map[string]int),
+		precompiles: make(map[types.Address]struct{}, len(precompiles)),
+	}
+
+	for _, addr := range precompiles {
+		t.precompiles[addr] = struct{}{}
+	}
+
+	return t
+}
+
+func (f *FourByteTracer) Cancel(err error) {
+	f.cancelLock.Lock()
+	defer f.cancelLock.Unlock()
+
+	f.reason = err
+	f.stop = true
+}
+
+func (f *FourByteTracer) cancelled() bool {
+	f.cancelLock.RLock()
+	defer f.cancelLock.RUnlock()
+
+	return f.stop
+}
+
+func (f *FourByteTracer) Clear() {
+	f.ids = make(map[string]int)
+}
+
+// GetResult returns the collected selectors with their number of occurrences
+func (f *FourByteTracer) GetResult() (interface{}, error) {
+	f.cancelLock.RLock()
+	defer f.cancelLock.RUnlock()
+
+	if f.reason != nil {
+		return nil, f.reason
+	}
+
+	res := make(map[string]int, len(f.ids))
+	for id, count := range f.ids {
+		res[id] = count
+	}
+
+	return res, nil
+}
+
+func (f *FourByteTracer) TxStart(gasLimit uint64) {
+}
+
+func (f *FourByteTracer) TxEnd(gasLeft uint64) {
+}
+
+func (f *FourByteTracer) CallStart(depth int, from, to types.Address, callType int,
+	gas uint64, value *big.Int, input []byte) {
+	switch runtime.CallType(callType) {
+	case runtime.Call, runtime.CallCode, runtime.DelegateCall, runtime.StaticCall:
+	default:
+		// contract creations have no selector
+		return
+	}
+
+	if len(input) < selectorLength {
+		return
+	}
+
+	if _, ok := f.precompiles[to]; ok {
+		return
+	}
+
+	id := fmt.Sprintf("%s-%d", hex.EncodeToHex(input[:selectorLength]), len(input)-selectorLength)
+	f.ids[id]++
+}
+
+func (f *FourByteTracer) CallEnd(depth int, output []byte, err error) {
+}
+
+func (f *FourByteTracer) CaptureState(memory []byte, stack []*big.Int, opCode int,
+	contractAddress types.Address, sp int, host tracer.RuntimeHost, state tracer.VMState) {
+	if f.cancelled() {
+		state.Halt()
+	}
+}
+
+func (f *FourByteTracer) ExecuteState(contractAddress types.Address, ip uint64, opcode string,
+	availableGas uint64, cost uint64, lastReturnData []byte, depth int, err error, host tracer.RuntimeHost) {
+}
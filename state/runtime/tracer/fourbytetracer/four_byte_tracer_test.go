@@ -0,0 +1,77 @@
+package fourbytetracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockVMState struct {
+	halted bool
+}
+
+func (m *mockVMState) Halt() {
+	m.halted = true
+}
+
+func TestFourByteTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+	tracer := NewFourByteTracer()
+
+	require.False(t, tracer.cancelled())
+
+	tracer.Cancel(err)
+
+	require.True(t, tracer.cancelled())
+
+	state := &mockVMState{}
+	tracer.CaptureState(nil, nil, evm.CALL, types.ZeroAddress, 0, nil, state)
+	require.True(t, state.halted)
+
+	res, resErr := tracer.GetResult()
+	require.Nil(t, res)
+	require.Equal(t, err, resErr)
+}
+
+func TestFourByteTracer_CountsSelectors(t *testing.T) {
+	t.Parallel()
+
+	var (
+		from       = types.StringToAddress("0x1")
+		to         = types.StringToAddress("0x2")
+		precompile = types.StringToAddress("0x3")
+		value      = big.NewInt(0)
+	)
+
+	tracer := NewFourByteTracer(precompile)
+
+	tracer.CallStart(1, from, to, int(runtime.Call), 100000, value, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x1, 0x2})
+	tracer.CallStart(2, to, from, int(runtime.StaticCall), 1000, value, []byte{0xa9, 0x05, 0x9c, 0xbb, 0x3, 0x4})
+	tracer.CallStart(2, to, from, int(runtime.DelegateCall), 1000, value, []byte{0x70, 0xa0, 0x82, 0x31})
+
+	// calls without a selector, to precompiles and contract creations are not counted
+	tracer.CallStart(2, to, from, int(runtime.Call), 1000, value, []byte{0x1})
+	tracer.CallStart(2, to, precompile, int(runtime.StaticCall), 1000, value, []byte{0x1, 0x2, 0x3, 0x4})
+	tracer.CallStart(2, to, from, int(runtime.Create), 1000, value, []byte{0x60, 0x80, 0x60, 0x40})
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Equal(t, map[string]int{
+		"0xa9059cbb-2": 2,
+		"0x70a08231-0": 1,
+	}, res)
+
+	tracer.Clear()
+
+	res, err = tracer.GetResult()
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
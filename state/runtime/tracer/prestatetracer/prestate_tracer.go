@@ -0,0 +1,332 @@
+package prestatetracer
+
+import (
+	"bytes"
+	"math/big"
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/crypto"
+	"github.com/0xPolygon/polygon-edge/helper/hex"
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/state/runtime/tracer"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Account is the state of a single account in the tracer result
+type Account struct {
+	Balance string                    `json:"balance,omitempty"`
+	Nonce   uint64                    `json:"nonce,omitempty"`
+	Code    string                    `json:"code,omitempty"`
+	Storage map[types.Hash]types.Hash `json:"storage,omitempty"`
+}
+
+// DiffResult is the result of the tracer in the diff mode
+type DiffResult struct {
+	Pre  map[types.Address]*Account `json:"pre"`
+	Post map[types.Address]*Account `json:"post"`
+}
+
+// maxInitCodeSize bounds the init code read from the memory for the CREATE2 address derivation
+const maxInitCodeSize = 2 * 0x6000
+
+type accountState struct {
+	exists  bool
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+// PrestateTracer collects the state of all the accounts touched by a transaction
+// before it is applied. In the diff mode, it returns only the accounts modified by the
+// transaction, together with their state after the transaction is applied
+type PrestateTracer struct {
+	diffMode bool
+	host     tracer.RuntimeHost
+	pre      map[types.Address]*accountState
+
+	cancelLock sync.RWMutex
+	reason     error
+	stop       bool
+}
+
+// NewPrestateTracer creates a new prestate tracer
+func NewPrestateTracer(diffMode bool) *PrestateTracer {
+	return &PrestateTracer{
+		diffMode: diffMode,
+		pre:      make(map[types.Address]*accountState),
+	}
+}
+
+func (p *PrestateTracer) Cancel(err error) {
+	p.cancelLock.Lock()
+	defer p.cancelLock.Unlock()
+
+	p.reason = err
+	p.stop = true
+}
+
+func (p *PrestateTracer) cancelled() bool {
+	p.cancelLock.RLock()
+	defer p.cancelLock.RUnlock()
+
+	return p.stop
+}
+
+func (p *PrestateTracer) Clear() {
+	p.host = nil
+	p.pre = make(map[types.Address]*accountState)
+}
+
+// GetResult returns the collected prestate of the accounts
+// or the pre and post state of the modified accounts in the diff mode
+func (p *PrestateTracer) GetResult() (interface{}, error) {
+	p.cancelLock.RLock()
+	defer p.cancelLock.RUnlock()
+
+	if p.reason != nil {
+		return nil, p.reason
+	}
+
+	if !p.diffMode {
+		pre := make(map[types.Address]*Account, len(p.pre))
+
+		for addr, state := range p.pre {
+			if state.exists {
+				pre[addr] = state.toAccount()
+			}
+		}
+
+		return pre, nil
+	}
+
+	return p.diffResult(), nil
+}
+
+func (p *PrestateTracer) diffResult() *DiffResult {
+	res := &DiffResult{
+		Pre:  make(map[types.Address]*Account),
+		Post: make(map[types.Address]*Account),
+	}
+
+	if p.host == nil {
+		return res
+	}
+
+	for addr, preState := range p.pre {
+		postState := p.readAccount(addr)
+
+		if !postState.exists {
+			// account got deleted by the transaction
+			if preState.exists {
+				res.Pre[addr] = preState.toAccount()
+			}
+
+			continue
+		}
+
+		var (
+			modified bool
+			pre      = preState.toAccount()
+			post     = &Account{}
+		)
+
+		if preState.balance.Cmp(postState.balance) != 0 {
+			modified = true
+			post.Balance = hex.EncodeBig(postState.balance)
+		}
+
+		if preState.nonce != postState.nonce {
+			modified = true
+			post.Nonce = postState.nonce
+		}
+
+		if !bytes.Equal(preState.code, postState.code) {
+			modified = true
+			post.Code = encodeCode(postState.code)
+		}
+
+		for slot, preValue := range preState.storage {
+			postValue := p.host.GetStorage(addr, slot)
+			if postValue == preValue {
+				delete(pre.Storage, slot)
+
+				continue
+			}
+
+			modified = true
+
+			if postValue != types.ZeroHash {
+				if post.Storage == nil {
+					post.Storage = make(map[types.Hash]types.Hash)
+				}
+
+				post.Storage[slot] = postValue
+			}
+		}
+
+		if !modified {
+			continue
+		}
+
+		if len(pre.Storage) == 0 {
+			pre.Storage = nil
+		}
+
+		res.Post[addr] = post
+
+		// newly created accounts had no prestate
+		if preState.exists {
+			res.Pre[addr] = pre
+		}
+	}
+
+	return res
+}
+
+// CaptureTxPreState captures the state of the sender, the receiver and the coinbase
+// before the transaction makes any state changes (e.g. the gas purchase)
+func (p *PrestateTracer) CaptureTxPreState(tx *types.Transaction, coinbase types.Address, host tracer.RuntimeHost) {
+	p.host = host
+
+	p.lookupAccount(tx.From())
+	p.lookupAccount(coinbase)
+
+	if tx.To() != nil {
+		p.lookupAccount(*tx.To())
+	} else {
+		p.lookupAccount(crypto.CreateAddress(tx.From(), host.GetNonce(tx.From())))
+	}
+}
+
+func (p *PrestateTracer) TxStart(gasLimit uint64) {
+}
+
+func (p *PrestateTracer) TxEnd(gasLeft uint64) {
+}
+
+func (p *PrestateTracer) CallStart(depth int, from, to types.Address, callType int,
+	gas uint64, value *big.Int, input []byte) {
+}
+
+func (p *PrestateTracer) CallEnd(depth int, output []byte, err error) {
+}
+
+func (p *PrestateTracer) CaptureState(memory []byte, stack []*big.Int, opCode int,
+	contractAddress types.Address, sp int, host tracer.RuntimeHost, state tracer.VMState) {
+	if p.cancelled() {
+		state.Halt()
+
+		return
+	}
+
+	if p.host == nil {
+		p.host = host
+	}
+
+	switch opCode {
+	case evm.SLOAD, evm.SSTORE:
+		if sp >= 1 {
+			p.lookupStorage(contractAddress, types.BytesToHash(stack[sp-1].Bytes()))
+		}
+
+	case evm.EXTCODECOPY, evm.EXTCODEHASH, evm.EXTCODESIZE, evm.BALANCE, evm.SELFDESTRUCT:
+		if sp >= 1 {
+			p.lookupAccount(types.BytesToAddress(stack[sp-1].Bytes()))
+		}
+
+	case evm.DELEGATECALL, evm.CALL, evm.STATICCALL, evm.CALLCODE:
+		if sp >= 5 {
+			p.lookupAccount(types.BytesToAddress(stack[sp-2].Bytes()))
+		}
+
+	case evm.CREATE:
+		p.lookupAccount(crypto.CreateAddress(contractAddress, p.host.GetNonce(contractAddress)))
+
+	case evm.CREATE2:
+		if sp >= 4 {
+			var salt [32]byte
+
+			stack[sp-4].FillBytes(salt[:])
+
+			initCode := memorySlice(memory, stack[sp-2], stack[sp-3])
+			p.lookupAccount(crypto.CreateAddress2(contractAddress, salt, initCode))
+		}
+	}
+}
+
+func (p *PrestateTracer) ExecuteState(contractAddress types.Address, ip uint64, opcode string,
+	availableGas uint64, cost uint64, lastReturnData []byte, depth int, err error, host tracer.RuntimeHost) {
+}
+
+// lookupAccount records the current state of the account if it is not recorded yet
+func (p *PrestateTracer) lookupAccount(addr types.Address) {
+	if _, ok := p.pre[addr]; ok {
+		return
+	}
+
+	p.pre[addr] = p.readAccount(addr)
+}
+
+// lookupStorage records the current value of the storage slot if it is not recorded yet
+func (p *PrestateTracer) lookupStorage(addr types.Address, slot types.Hash) {
+	p.lookupAccount(addr)
+
+	if _, ok := p.pre[addr].storage[slot]; ok {
+		return
+	}
+
+	p.pre[addr].storage[slot] = p.host.GetStorage(addr, slot)
+}
+
+func (p *PrestateTracer) readAccount(addr types.Address) *accountState {
+	return &accountState{
+		exists:  p.host.AccountExists(addr),
+		balance: new(big.Int).Set(p.host.GetBalance(addr)),
+		nonce:   p.host.GetNonce(addr),
+		code:    p.host.GetCode(addr),
+		storage: make(map[types.Hash]types.Hash),
+	}
+}
+
+func (s *accountState) toAccount() *Account {
+	acc := &Account{
+		Balance: hex.EncodeBig(s.balance),
+		Nonce:   s.nonce,
+		Code:    encodeCode(s.code),
+	}
+
+	if len(s.storage) > 0 {
+		acc.Storage = make(map[types.Hash]types.Hash, len(s.storage))
+
+		for slot, value := range s.storage {
+			acc.Storage[slot] = value
+		}
+	}
+
+	return acc
+}
+
+func encodeCode(code []byte) string {
+	if len(code) == 0 {
+		return ""
+	}
+
+	return hex.EncodeToHex(code)
+}
+
+// memorySlice returns the copy of the memory at the given offset and size,
+// padded with zeros if the memory is not expanded yet
+func memorySlice(memory []byte, offset, size *big.Int) []byte {
+	if !offset.IsUint64() || !size.IsUint64() || size.Uint64() > maxInitCodeSize {
+		return nil
+	}
+
+	res := make([]byte, size.Uint64())
+
+	if start := offset.Uint64(); start < uint64(len(memory)) {
+		copy(res, memory[start:])
+	}
+
+	return res
+}
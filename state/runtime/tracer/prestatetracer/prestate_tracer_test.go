@@ -0,0 +1,201 @@
+package prestatetracer
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/state/runtime/evm"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+type mockVMState struct {
+	halted bool
+}
+
+func (m *mockVMState) Halt() {
+	m.halted = true
+}
+
+type mockAccount struct {
+	balance *big.Int
+	nonce   uint64
+	code    []byte
+	storage map[types.Hash]types.Hash
+}
+
+type mockHost struct {
+	accounts map[types.Address]*mockAccount
+}
+
+func (m *mockHost) GetRefund() uint64 {
+	return 0
+}
+
+func (m *mockHost) GetStorage(addr types.Address, slot types.Hash) types.Hash {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.storage[slot]
+	}
+
+	return types.ZeroHash
+}
+
+func (m *mockHost) AccountExists(addr types.Address) bool {
+	_, ok := m.accounts[addr]
+
+	return ok
+}
+
+func (m *mockHost) GetBalance(addr types.Address) *big.Int {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.balance
+	}
+
+	return big.NewInt(0)
+}
+
+func (m *mockHost) GetNonce(addr types.Address) uint64 {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.nonce
+	}
+
+	return 0
+}
+
+func (m *mockHost) GetCode(addr types.Address) []byte {
+	if acc, ok := m.accounts[addr]; ok {
+		return acc.code
+	}
+
+	return nil
+}
+
+var (
+	sender   = types.StringToAddress("0x1")
+	receiver = types.StringToAddress("0x2")
+	coinbase = types.StringToAddress("0x3")
+	callee   = types.StringToAddress("0x4")
+	slot1    = types.StringToHash("0x10")
+	slot2    = types.StringToHash("0x20")
+)
+
+func newMockHost() *mockHost {
+	return &mockHost{
+		accounts: map[types.Address]*mockAccount{
+			sender: {balance: big.NewInt(1000), nonce: 1},
+			receiver: {
+				balance: big.NewInt(0),
+				code:    []byte{0x60, 0x00},
+				storage: map[types.Hash]types.Hash{
+					slot1: types.StringToHash("0x1"),
+					slot2: types.StringToHash("0x2"),
+				},
+			},
+		},
+	}
+}
+
+// traceTx simulates a transaction from the sender to the receiver,
+// which reads slot1, writes slot2 and calls a non-existent account
+func traceTx(tracer *PrestateTracer, host *mockHost) {
+	tx := types.NewTx(types.NewLegacyTx(
+		types.WithFrom(sender),
+		types.WithTo(&receiver),
+		types.WithValue(big.NewInt(10)),
+	))
+
+	tracer.CaptureTxPreState(tx, coinbase, host)
+
+	state := &mockVMState{}
+
+	tracer.CaptureState(nil, []*big.Int{new(big.Int).SetBytes(slot1.Bytes())}, evm.SLOAD, receiver, 1, host, state)
+	tracer.CaptureState(nil, []*big.Int{big.NewInt(5), new(big.Int).SetBytes(slot2.Bytes())}, evm.SSTORE, receiver, 2, host, state)
+
+	callStack := []*big.Int{
+		big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0), big.NewInt(0),
+		new(big.Int).SetBytes(callee.Bytes()), big.NewInt(1000),
+	}
+	tracer.CaptureState(nil, callStack, evm.CALL, receiver, len(callStack), host, state)
+
+	// apply the state changes of the transaction
+	host.accounts[sender].balance = big.NewInt(990)
+	host.accounts[sender].nonce = 2
+	host.accounts[receiver].balance = big.NewInt(10)
+	host.accounts[receiver].storage[slot2] = types.ZeroHash
+}
+
+func TestPrestateTracer_Cancel(t *testing.T) {
+	t.Parallel()
+
+	err := errors.New("timeout")
+	tracer := NewPrestateTracer(false)
+
+	require.False(t, tracer.cancelled())
+
+	tracer.Cancel(err)
+
+	require.True(t, tracer.cancelled())
+
+	state := &mockVMState{}
+	tracer.CaptureState(nil, nil, evm.SLOAD, types.ZeroAddress, 0, nil, state)
+	require.True(t, state.halted)
+
+	res, resErr := tracer.GetResult()
+	require.Nil(t, res)
+	require.Equal(t, err, resErr)
+}
+
+func TestPrestateTracer_Prestate(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewPrestateTracer(false)
+	traceTx(tracer, newMockHost())
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Equal(t, map[types.Address]*Account{
+		sender: {Balance: "0x3e8", Nonce: 1},
+		receiver: {
+			Balance: "0x0",
+			Code:    "0x6000",
+			Storage: map[types.Hash]types.Hash{
+				slot1: types.StringToHash("0x1"),
+				slot2: types.StringToHash("0x2"),
+			},
+		},
+	}, res)
+
+	tracer.Clear()
+
+	res, err = tracer.GetResult()
+	require.NoError(t, err)
+	require.Empty(t, res)
+}
+
+func TestPrestateTracer_DiffMode(t *testing.T) {
+	t.Parallel()
+
+	tracer := NewPrestateTracer(true)
+	traceTx(tracer, newMockHost())
+
+	res, err := tracer.GetResult()
+	require.NoError(t, err)
+	require.Equal(t, &DiffResult{
+		Pre: map[types.Address]*Account{
+			sender: {Balance: "0x3e8", Nonce: 1},
+			receiver: {
+				Balance: "0x0",
+				Code:    "0x6000",
+				Storage: map[types.Hash]types.Hash{
+					slot2: types.StringToHash("0x2"),
+				},
+			},
+		},
+		Post: map[types.Address]*Account{
+			sender:   {Balance: "0x3de", Nonce: 2},
+			receiver: {Balance: "0xa"},
+		},
+	}, res)
+}
@@ -43,6 +43,22 @@ func (m *mockHost) GetStorage(a types.Address, h types.Hash) types.Hash {
 	return m.getStorageFunc(a, h)
 }
 
+func (m *mockHost) AccountExists(types.Address) bool {
+	return true
+}
+
+func (m *mockHost) GetBalance(types.Address) *big.Int {
+	return big.NewInt(0)
+}
+
+func (m *mockHost) GetNonce(types.Address) uint64 {
+	return 0
+}
+
+func (m *mockHost) GetCode(types.Address) []byte {
+	return nil
+}
+
 func TestStructLogErrorString(t *testing.T) {
 	t.Parallel()
 
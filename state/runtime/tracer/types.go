@@ -12,6 +12,14 @@ type RuntimeHost interface {
 	GetRefund() uint64
 	// GetStorage access the storage slot at the given address and slot hash
 	GetStorage(types.Address, types.Hash) types.Hash
+	// AccountExists returns true if the account with the given address exists
+	AccountExists(types.Address) bool
+	// GetBalance returns the balance of the given address
+	GetBalance(types.Address) *big.Int
+	// GetNonce returns the nonce of the given address
+	GetNonce(types.Address) uint64
+	// GetCode returns the code of the given address
+	GetCode(types.Address) []byte
 }
 
 type VMState interface {
@@ -68,3 +76,10 @@ type Tracer interface {
 		host RuntimeHost,
 	)
 }
+
+// PreStateTracer is implemented by tracers which need to access the state
+// of the accounts before the transaction is applied
+type PreStateTracer interface {
+	// CaptureTxPreState is called before any state change of the transaction is made
+	CaptureTxPreState(tx *types.Transaction, coinbase types.Address, host RuntimeHost)
+}
@@ -0,0 +1,31 @@
+package runtime
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// TransientStorage is the per transaction storage accessed by TLOAD and TSTORE (EIP-1153),
+// it is discarded at the end of the transaction
+type TransientStorage map[types.Address]map[types.Hash]types.Hash
+
+func NewTransientStorage() *TransientStorage {
+	ts := make(TransientStorage)
+
+	return &ts
+}
+
+// Get returns the transient storage value of the account slot
+func (ts *TransientStorage) Get(addr types.Address, key types.Hash) types.Hash {
+	return (*ts)[addr][key]
+}
+
+// Set sets the transient storage value of the account slot
+func (ts *TransientStorage) Set(addr types.Address, key types.Hash, value types.Hash) {
+	slots, ok := (*ts)[addr]
+	if !ok {
+		slots = make(map[types.Hash]types.Hash)
+		(*ts)[addr] = slots
+	}
+
+	slots[key] = value
+}
@@ -2,6 +2,7 @@ package state
 
 import (
 	"bytes"
+	"errors"
 	"fmt"
 	"math/big"
 
@@ -11,6 +12,10 @@ import (
 	"github.com/0xPolygon/polygon-edge/types"
 )
 
+// ErrStateNotAvailable is returned when the state at the requested root is not stored,
+// e.g. because it was pruned
+var ErrStateNotAvailable = errors.New("state not available")
+
 type State interface {
 	NewSnapshotAt(types.Hash) (Snapshot, error)
 	NewSnapshot() Snapshot
@@ -23,6 +28,13 @@ type Snapshot interface {
 	Commit(objs []*Object) (Snapshot, []byte, error)
 }
 
+// SnapshotProver is implemented by snapshots which are able to produce
+// merkle proofs for accounts and storage slots
+type SnapshotProver interface {
+	GetAccountProof(addr types.Address) ([][]byte, error)
+	GetStorageProof(root types.Hash, key types.Hash) ([][]byte, error)
+}
+
 // Account is the account reference in the ethereum state
 type Account struct {
 	Nonce    uint64
@@ -36,6 +36,9 @@ var (
 
 	// refundIndex is the index of the refund
 	refundIndex = types.BytesToHash([]byte{3}).Bytes()
+
+	// createdIndex is the prefix of the keys of the accounts created in the transaction
+	createdIndex = types.BytesToHash([]byte{4}).Bytes()
 )
 
 // Txn is a reference of the state
@@ -462,6 +465,24 @@ func (txn *Txn) HasSuicided(addr types.Address) bool {
 	return exists && object.Suicide
 }
 
+// MarkCreated marks the given account as created in the current transaction
+func (txn *Txn) MarkCreated(addr types.Address) {
+	txn.txn.Insert(createdKey(addr), true)
+}
+
+// IsCreated returns true if the account was created in the current transaction
+func (txn *Txn) IsCreated(addr types.Address) bool {
+	_, created := txn.txn.Get(createdKey(addr))
+
+	return created
+}
+
+// createdKey returns the key marking the account as created,
+// which is longer than the account keys so they never collide
+func createdKey(addr types.Address) []byte {
+	return append(append(make([]byte, 0, len(createdIndex)+types.AddressLength), createdIndex...), addr.Bytes()...)
+}
+
 // Refund
 func (txn *Txn) AddRefund(gas uint64) {
 	refund := txn.GetRefund() + gas
@@ -594,8 +615,9 @@ func (txn *Txn) CleanDeleteObjects(deleteEmptyObjects bool) error {
 		txn.txn.Insert(k, obj2)
 	}
 
-	// delete refunds
+	// delete refunds and the accounts created in the transaction
 	txn.txn.Delete(refundIndex)
+	txn.txn.DeletePrefix(createdIndex)
 
 	return nil
 }
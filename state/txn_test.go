@@ -92,3 +92,24 @@ func TestIncrNonce(t *testing.T) {
 	require.NoError(t, txn.IncrNonce(address1))
 	require.Equal(t, nonMaxUint64NonceValue+1, txn.GetNonce(address1))
 }
+
+func TestMarkCreated(t *testing.T) {
+	t.Parallel()
+
+	txn := newTestTxn(defaultPreState)
+	txn.MarkCreated(addr1)
+
+	ss := txn.Snapshot()
+	txn.MarkCreated(addr2)
+	assert.True(t, txn.IsCreated(addr1))
+	assert.True(t, txn.IsCreated(addr2))
+
+	// the accounts created after the snapshot are forgotten
+	assert.NoError(t, txn.RevertToSnapshot(ss))
+	assert.True(t, txn.IsCreated(addr1))
+	assert.False(t, txn.IsCreated(addr2))
+
+	// the accounts are only tracked until the end of the transaction
+	assert.NoError(t, txn.CleanDeleteObjects(true))
+	assert.False(t, txn.IsCreated(addr1))
+}
@@ -24,6 +24,7 @@ const (
 	SyncPeerClientLoggerName = "sync-peer-client"
 	statusTopicName          = "syncer/status/0.1"
 	defaultTimeoutForStatus  = 10 * time.Second
+	defaultTimeoutForState   = 30 * time.Second
 )
 
 type syncPeerClient struct {
@@ -347,6 +348,42 @@ func (m *syncPeerClient) GetBlocks(
 	return blockCh, nil
 }
 
+// GetStateData fetches the trie nodes and contract codes with given hashes from peer.
+// The returned data is not verified, the peer may skip the items it doesn't have
+func (m *syncPeerClient) GetStateData(
+	peerID peer.ID,
+	nodeHashes []types.Hash,
+	codeHashes []types.Hash,
+) ([][]byte, [][]byte, error) {
+	clt, err := m.newSyncPeerClient(peerID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to create sync peer client: %w", err)
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(context.Background(), defaultTimeoutForState)
+	defer cancel()
+
+	req := &proto.GetStateDataRequest{
+		NodeHashes: make([][]byte, len(nodeHashes)),
+		CodeHashes: make([][]byte, len(codeHashes)),
+	}
+
+	for i, hash := range nodeHashes {
+		req.NodeHashes[i] = hash.Bytes()
+	}
+
+	for i, hash := range codeHashes {
+		req.CodeHashes[i] = hash.Bytes()
+	}
+
+	resp, err := clt.GetStateData(timeoutCtx, req)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return resp.Nodes, resp.Codes, nil
+}
+
 // newSyncPeerClient creates gRPC client
 func (m *syncPeerClient) newSyncPeerClient(peerID peer.ID) (proto.SyncPeerClient, error) {
 	conn, err := m.network.NewProtoConnection(syncerProto, peerID)
@@ -173,6 +173,122 @@ func (x *SyncPeerStatus) GetNumber() uint64 {
 	return 0
 }
 
+// GetStateDataRequest is a request for GetStateData
+type GetStateDataRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Hashes of the requested trie nodes
+	NodeHashes [][]byte `protobuf:"bytes,1,rep,name=node_hashes,json=nodeHashes,proto3" json:"node_hashes,omitempty"`
+	// Hashes of the requested contract codes
+	CodeHashes [][]byte `protobuf:"bytes,2,rep,name=code_hashes,json=codeHashes,proto3" json:"code_hashes,omitempty"`
+}
+
+func (x *GetStateDataRequest) Reset() {
+	*x = GetStateDataRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_syncer_proto_syncer_proto_msgTypes[3]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetStateDataRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetStateDataRequest) ProtoMessage() {}
+
+func (x *GetStateDataRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_syncer_proto_syncer_proto_msgTypes[3]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetStateDataRequest.ProtoReflect.Descriptor instead.
+func (*GetStateDataRequest) Descriptor() ([]byte, []int) {
+	return file_syncer_proto_syncer_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *GetStateDataRequest) GetNodeHashes() [][]byte {
+	if x != nil {
+		return x.NodeHashes
+	}
+	return nil
+}
+
+func (x *GetStateDataRequest) GetCodeHashes() [][]byte {
+	if x != nil {
+		return x.CodeHashes
+	}
+	return nil
+}
+
+// StateData contains trie nodes and contract codes of the state
+type StateData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Requested trie nodes which are found
+	Nodes [][]byte `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes,omitempty"`
+	// Requested contract codes which are found
+	Codes [][]byte `protobuf:"bytes,2,rep,name=codes,proto3" json:"codes,omitempty"`
+}
+
+func (x *StateData) Reset() {
+	*x = StateData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_syncer_proto_syncer_proto_msgTypes[4]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *StateData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StateData) ProtoMessage() {}
+
+func (x *StateData) ProtoReflect() protoreflect.Message {
+	mi := &file_syncer_proto_syncer_proto_msgTypes[4]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StateData.ProtoReflect.Descriptor instead.
+func (*StateData) Descriptor() ([]byte, []int) {
+	return file_syncer_proto_syncer_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *StateData) GetNodes() [][]byte {
+	if x != nil {
+		return x.Nodes
+	}
+	return nil
+}
+
+func (x *StateData) GetCodes() [][]byte {
+	if x != nil {
+		return x.Codes
+	}
+	return nil
+}
+
 var File_syncer_proto_syncer_proto protoreflect.FileDescriptor
 
 var file_syncer_proto_syncer_proto_rawDesc = []byte{
@@ -186,16 +302,29 @@ var file_syncer_proto_syncer_proto_rawDesc = []byte{
 	0x05, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x62, 0x6c,
 	0x6f, 0x63, 0x6b, 0x22, 0x28, 0x0a, 0x0e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x53,
 	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x32, 0x73, 0x0a,
-	0x08, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x42,
-	0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x09, 0x2e, 0x76,
-	0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x09, 0x47, 0x65, 0x74,
-	0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x12,
-	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x53, 0x74, 0x61, 0x74,
-	0x75, 0x73, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x22, 0x57, 0x0a,
+	0x13, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1f, 0x0a, 0x0b, 0x6e, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61, 0x73,
+	0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x6e, 0x6f, 0x64, 0x65, 0x48,
+	0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x64, 0x65, 0x5f, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x0a, 0x63, 0x6f, 0x64, 0x65,
+	0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x22, 0x37, 0x0a, 0x09, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0c, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x6f, 0x64,
+	0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x6f, 0x64, 0x65, 0x73, 0x32,
+	0xab, 0x01, 0x0a, 0x08, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x12, 0x2e, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x47,
+	0x65, 0x74, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x09, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x30, 0x01, 0x12, 0x37, 0x0a, 0x09,
+	0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x1a, 0x12, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x79, 0x6e, 0x63, 0x50, 0x65, 0x65, 0x72, 0x53,
+	0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x36, 0x0a, 0x0c, 0x47, 0x65, 0x74, 0x53, 0x74, 0x61, 0x74,
+	0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x53, 0x74,
+	0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0d,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x74, 0x61, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x42, 0x0f, 0x5a,
+	0x0d, 0x2f, 0x73, 0x79, 0x6e, 0x63, 0x65, 0x72, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -210,20 +339,24 @@ func file_syncer_proto_syncer_proto_rawDescGZIP() []byte {
 	return file_syncer_proto_syncer_proto_rawDescData
 }
 
-var file_syncer_proto_syncer_proto_msgTypes = make([]protoimpl.MessageInfo, 3)
+var file_syncer_proto_syncer_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
 var file_syncer_proto_syncer_proto_goTypes = []interface{}{
-	(*GetBlocksRequest)(nil), // 0: v1.GetBlocksRequest
-	(*Block)(nil),            // 1: v1.Block
-	(*SyncPeerStatus)(nil),   // 2: v1.SyncPeerStatus
-	(*emptypb.Empty)(nil),    // 3: google.protobuf.Empty
+	(*GetBlocksRequest)(nil),    // 0: v1.GetBlocksRequest
+	(*Block)(nil),               // 1: v1.Block
+	(*SyncPeerStatus)(nil),      // 2: v1.SyncPeerStatus
+	(*GetStateDataRequest)(nil), // 3: v1.GetStateDataRequest
+	(*StateData)(nil),           // 4: v1.StateData
+	(*emptypb.Empty)(nil),       // 5: google.protobuf.Empty
 }
 var file_syncer_proto_syncer_proto_depIdxs = []int32{
 	0, // 0: v1.SyncPeer.GetBlocks:input_type -> v1.GetBlocksRequest
-	3, // 1: v1.SyncPeer.GetStatus:input_type -> google.protobuf.Empty
-	1, // 2: v1.SyncPeer.GetBlocks:output_type -> v1.Block
-	2, // 3: v1.SyncPeer.GetStatus:output_type -> v1.SyncPeerStatus
-	2, // [2:4] is the sub-list for method output_type
-	0, // [0:2] is the sub-list for method input_type
+	5, // 1: v1.SyncPeer.GetStatus:input_type -> google.protobuf.Empty
+	3, // 2: v1.SyncPeer.GetStateData:input_type -> v1.GetStateDataRequest
+	1, // 3: v1.SyncPeer.GetBlocks:output_type -> v1.Block
+	2, // 4: v1.SyncPeer.GetStatus:output_type -> v1.SyncPeerStatus
+	4, // 5: v1.SyncPeer.GetStateData:output_type -> v1.StateData
+	3, // [3:6] is the sub-list for method output_type
+	0, // [0:3] is the sub-list for method input_type
 	0, // [0:0] is the sub-list for extension type_name
 	0, // [0:0] is the sub-list for extension extendee
 	0, // [0:0] is the sub-list for field type_name
@@ -271,6 +404,30 @@ func file_syncer_proto_syncer_proto_init() {
 				return nil
 			}
 		}
+		file_syncer_proto_syncer_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetStateDataRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_syncer_proto_syncer_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*StateData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -278,7 +435,7 @@ func file_syncer_proto_syncer_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_syncer_proto_syncer_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   3,
+			NumMessages:   5,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
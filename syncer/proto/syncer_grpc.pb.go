@@ -22,6 +22,8 @@ type SyncPeerClient interface {
 	GetBlocks(ctx context.Context, in *GetBlocksRequest, opts ...grpc.CallOption) (SyncPeer_GetBlocksClient, error)
 	// Returns server's status
 	GetStatus(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*SyncPeerStatus, error)
+	// Returns trie nodes and contract codes of the state by their hashes
+	GetStateData(ctx context.Context, in *GetStateDataRequest, opts ...grpc.CallOption) (*StateData, error)
 }
 
 type syncPeerClient struct {
@@ -73,6 +75,15 @@ func (c *syncPeerClient) GetStatus(ctx context.Context, in *emptypb.Empty, opts
 	return out, nil
 }
 
+func (c *syncPeerClient) GetStateData(ctx context.Context, in *GetStateDataRequest, opts ...grpc.CallOption) (*StateData, error) {
+	out := new(StateData)
+	err := c.cc.Invoke(ctx, "/v1.SyncPeer/GetStateData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // SyncPeerServer is the server API for SyncPeer service.
 // All implementations must embed UnimplementedSyncPeerServer
 // for forward compatibility
@@ -81,6 +92,8 @@ type SyncPeerServer interface {
 	GetBlocks(*GetBlocksRequest, SyncPeer_GetBlocksServer) error
 	// Returns server's status
 	GetStatus(context.Context, *emptypb.Empty) (*SyncPeerStatus, error)
+	// Returns trie nodes and contract codes of the state by their hashes
+	GetStateData(context.Context, *GetStateDataRequest) (*StateData, error)
 	mustEmbedUnimplementedSyncPeerServer()
 }
 
@@ -94,6 +107,9 @@ func (UnimplementedSyncPeerServer) GetBlocks(*GetBlocksRequest, SyncPeer_GetBloc
 func (UnimplementedSyncPeerServer) GetStatus(context.Context, *emptypb.Empty) (*SyncPeerStatus, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetStatus not implemented")
 }
+func (UnimplementedSyncPeerServer) GetStateData(context.Context, *GetStateDataRequest) (*StateData, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStateData not implemented")
+}
 func (UnimplementedSyncPeerServer) mustEmbedUnimplementedSyncPeerServer() {}
 
 // UnsafeSyncPeerServer may be embedded to opt out of forward compatibility for this service.
@@ -146,6 +162,24 @@ func _SyncPeer_GetStatus_Handler(srv interface{}, ctx context.Context, dec func(
 	return interceptor(ctx, in, info, handler)
 }
 
+func _SyncPeer_GetStateData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStateDataRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(SyncPeerServer).GetStateData(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.SyncPeer/GetStateData",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(SyncPeerServer).GetStateData(ctx, req.(*GetStateDataRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _SyncPeer_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "v1.SyncPeer",
 	HandlerType: (*SyncPeerServer)(nil),
@@ -154,6 +188,10 @@ var _SyncPeer_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetStatus",
 			Handler:    _SyncPeer_GetStatus_Handler,
 		},
+		{
+			MethodName: "GetStateData",
+			Handler:    _SyncPeer_GetStateData_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
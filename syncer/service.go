@@ -3,8 +3,10 @@ package syncer
 import (
 	"context"
 	"errors"
+	"fmt"
 
 	"github.com/0xPolygon/polygon-edge/network/grpc"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/syncer/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/armon/go-metrics"
@@ -12,24 +14,28 @@ import (
 )
 
 var (
-	ErrBlockNotFound = errors.New("block not found")
+	ErrBlockNotFound         = errors.New("block not found")
+	ErrStateDataNotAvailable = errors.New("state data is not available")
 )
 
 type syncPeerService struct {
 	proto.UnimplementedSyncPeerServer
 
-	blockchain Blockchain       // reference to the blockchain module
-	network    Network          // reference to the network module
-	stream     *grpc.GrpcStream // reference to the grpc stream
+	blockchain   Blockchain       // reference to the blockchain module
+	network      Network          // reference to the network module
+	stateStorage itrie.Storage    // reference to the state trie storage
+	stream       *grpc.GrpcStream // reference to the grpc stream
 }
 
 func NewSyncPeerService(
 	network Network,
 	blockchain Blockchain,
+	stateStorage itrie.Storage,
 ) SyncPeerService {
 	return &syncPeerService{
-		blockchain: blockchain,
-		network:    network,
+		blockchain:   blockchain,
+		network:      network,
+		stateStorage: stateStorage,
 	}
 }
 
@@ -91,6 +97,57 @@ func (s *syncPeerService) GetStatus(
 	}, nil
 }
 
+// GetStateData is a gRPC endpoint to return the trie nodes and contract codes of the state by their hashes.
+// The ones missing from the storage are skipped, the client verifies the returned data against the hashes
+func (s *syncPeerService) GetStateData(
+	ctx context.Context,
+	req *proto.GetStateDataRequest,
+) (*proto.StateData, error) {
+	if s.stateStorage == nil {
+		return nil, ErrStateDataNotAvailable
+	}
+
+	if len(req.NodeHashes) > maxStateNodesPerRequest || len(req.CodeHashes) > maxStateCodesPerRequest {
+		return nil, fmt.Errorf("too many state items requested, nodes %d, codes %d",
+			len(req.NodeHashes), len(req.CodeHashes))
+	}
+
+	resp := &proto.StateData{
+		Nodes: make([][]byte, 0, len(req.NodeHashes)),
+		Codes: make([][]byte, 0, len(req.CodeHashes)),
+	}
+
+	size := 0
+
+	for _, hash := range req.NodeHashes {
+		// the trie nodes are stored by their hashes, other keys must not be served
+		if len(hash) != types.HashLength {
+			continue
+		}
+
+		node, ok, err := s.stateStorage.Get(hash)
+		if err != nil {
+			return nil, err
+		}
+
+		if ok {
+			resp.Nodes = append(resp.Nodes, node)
+			size += len(node)
+		}
+	}
+
+	for _, hash := range req.CodeHashes {
+		if code, ok := s.stateStorage.GetCode(types.BytesToHash(hash)); ok {
+			resp.Codes = append(resp.Codes, code)
+			size += len(code)
+		}
+	}
+
+	metrics.SetGauge([]string{syncerMetrics, "state_egress_bytes"}, float32(size))
+
+	return resp, nil
+}
+
 // toProtoBlock converts type.Block -> proto.Block
 func toProtoBlock(block *types.Block) *proto.Block {
 	return &proto.Block{
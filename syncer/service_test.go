@@ -7,9 +7,12 @@ import (
 	"net"
 	"testing"
 
+	"github.com/0xPolygon/polygon-edge/crypto"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/syncer/proto"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/test/bufconn"
@@ -154,3 +157,36 @@ func TestGetStatus(t *testing.T) {
 	assert.NoError(t, err)
 	assert.Equal(t, headerNumber, status.Number)
 }
+
+func TestGetStateData(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+
+	node := []byte{0xc2, 0x01, 0x02}
+	nodeHash := types.BytesToHash(crypto.Keccak256(node))
+	code := []byte{0x60, 0x01}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	require.NoError(t, storage.Put(nodeHash.Bytes(), node))
+	require.NoError(t, storage.SetCode(codeHash, code))
+
+	client := newMockGrpcClient(t, &syncPeerService{stateStorage: storage})
+
+	// the missing items and the keys which are not hashes are skipped
+	data, err := client.GetStateData(context.Background(), &proto.GetStateDataRequest{
+		NodeHashes: [][]byte{nodeHash.Bytes(), types.StringToHash("0x1").Bytes(), codeHash.Bytes()[:10]},
+		CodeHashes: [][]byte{codeHash.Bytes(), types.StringToHash("0x2").Bytes()},
+	})
+
+	require.NoError(t, err)
+	assert.Equal(t, [][]byte{node}, data.Nodes)
+	assert.Equal(t, [][]byte{code}, data.Codes)
+
+	// the number of the requested items is limited
+	_, err = client.GetStateData(context.Background(), &proto.GetStateDataRequest{
+		NodeHashes: make([][]byte, maxStateNodesPerRequest+1),
+	})
+
+	assert.Error(t, err)
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network/event"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/armon/go-metrics"
 	"github.com/hashicorp/go-hclog"
@@ -16,10 +17,20 @@ import (
 const (
 	syncerName  = "syncer"
 	syncerProto = "/syncer/0.2"
+
+	// statePivotOffset is the distance of the pivot block from the latest block of the peer,
+	// so that the pivot state is still kept by the peers which prune the old states
+	statePivotOffset = 64
+	// maxStateNodesPerRequest is the maximum number of trie nodes requested from a peer at once
+	maxStateNodesPerRequest = 384
+	// maxStateCodesPerRequest is the maximum number of contract codes requested from a peer at once
+	maxStateCodesPerRequest = 64
 )
 
 var (
-	errTimeout = errors.New("timeout awaiting block from peer")
+	errTimeout       = errors.New("timeout awaiting block from peer")
+	errNoStateData   = errors.New("peer returned none of the requested state data")
+	errPivotNotFound = errors.New("peer stopped sending blocks before the pivot block")
 )
 
 // XXX: Don't use this syncer for the consensus that may cause fork.
@@ -36,6 +47,11 @@ type syncer struct {
 	// Timeout for syncing a block
 	blockTimeout time.Duration
 
+	// Mode of syncing with the peers
+	syncMode SyncMode
+	// Storage of the state trie, the state sync downloads the pivot state into it
+	stateStorage itrie.Storage
+
 	// Channel to notify Sync that a new status arrived
 	newStatusCh chan struct{}
 }
@@ -45,14 +61,18 @@ func NewSyncer(
 	network Network,
 	blockchain Blockchain,
 	blockTimeout time.Duration,
+	syncMode SyncMode,
+	stateStorage itrie.Storage,
 ) Syncer {
 	return &syncer{
 		logger:          logger.Named(syncerName),
 		blockchain:      blockchain,
 		syncProgression: progress.NewProgressionWrapper(progress.ChainSyncBulk),
-		syncPeerService: NewSyncPeerService(network, blockchain),
+		syncPeerService: NewSyncPeerService(network, blockchain, stateStorage),
 		syncPeerClient:  NewSyncPeerClient(logger, network, blockchain),
 		blockTimeout:    blockTimeout,
+		syncMode:        syncMode,
+		stateStorage:    stateStorage,
 		newStatusCh:     make(chan struct{}),
 		peerMap:         new(PeerMap),
 	}
@@ -187,6 +207,22 @@ func (s *syncer) Sync(callback func(*types.FullBlock) bool) error {
 			continue
 		}
 
+		// download the state of a recent block instead of executing all the blocks preceding it
+		if pivot, ok := s.statePivot(bestPeer.Number); ok {
+			shouldTerminate, err := s.stateSyncWithPeer(bestPeer.ID, pivot, callback)
+			if err != nil {
+				s.logger.Warn("failed to complete state sync with peer, try to next one", "peer ID", bestPeer.ID, "error", err)
+
+				skipList[bestPeer.ID] = true
+
+				continue
+			}
+
+			if shouldTerminate {
+				break
+			}
+		}
+
 		// fetch block from the peer
 		lastNumber, shouldTerminate, err := s.bulkSyncWithPeer(bestPeer.ID, bestPeer.Number, callback)
 		if err != nil {
@@ -272,6 +308,176 @@ func (s *syncer) bulkSyncWithPeer(peerID peer.ID, peerLatestBlock uint64,
 	}
 }
 
+// statePivot returns the block whose state should be synced from a peer with the given latest block.
+// The state is synced only by a fresh node, or to complete the state sync which has been interrupted
+func (s *syncer) statePivot(peerLatestBlock uint64) (uint64, bool) {
+	if s.syncMode != StateSyncMode || s.stateStorage == nil {
+		return 0, false
+	}
+
+	header := s.blockchain.Header()
+	if header.Number > 0 && s.hasState(header.StateRoot) {
+		return 0, false
+	}
+
+	if peerLatestBlock > header.Number+statePivotOffset {
+		return peerLatestBlock - statePivotOffset, true
+	}
+
+	// the state of the latest block is missing, since the previous state sync has been interrupted
+	return header.Number, header.Number > 0
+}
+
+// hasState returns true if the state with the given root is in the storage.
+// The state sync stores the root node the last, so the state is complete once the root is present
+func (s *syncer) hasState(root types.Hash) bool {
+	if root == types.EmptyRootHash {
+		return true
+	}
+
+	_, ok, err := s.stateStorage.Get(root.Bytes())
+
+	return err == nil && ok
+}
+
+// stateSyncWithPeer writes the blocks up to the pivot without executing them and downloads
+// the pivot state from a given peer. The callback is called for the pivot block only,
+// since the state of the preceding blocks is not available
+func (s *syncer) stateSyncWithPeer(peerID peer.ID, pivot uint64,
+	newBlockCallback func(*types.FullBlock) bool) (bool, error) {
+	// the blocks up to the pivot are not executed, so their receipts are not available
+	if err := s.blockchain.SetFirstReceiptsNumber(pivot + 1); err != nil {
+		return false, err
+	}
+
+	pivotBlock, err := s.headerSyncWithPeer(peerID, pivot)
+	if err != nil {
+		return false, err
+	}
+
+	if err := s.syncStateWithPeer(peerID, pivotBlock.Header.StateRoot); err != nil {
+		return false, fmt.Errorf("failed to sync state of block %d: %w", pivot, err)
+	}
+
+	s.logger.Info("state synced", "pivot", pivot, "root", pivotBlock.Header.StateRoot)
+
+	return newBlockCallback(&types.FullBlock{Block: pivotBlock}), nil
+}
+
+// headerSyncWithPeer writes the blocks up to the pivot from a given peer, verifying them
+// without the execution, and returns the pivot block
+func (s *syncer) headerSyncWithPeer(peerID peer.ID, pivot uint64) (*types.Block, error) {
+	localLatest := s.blockchain.Header().Number
+
+	if localLatest >= pivot {
+		block, ok := s.blockchain.GetBlockByNumber(pivot, true)
+		if !ok {
+			return nil, ErrBlockNotFound
+		}
+
+		return block, nil
+	}
+
+	blockCh, err := s.syncPeerClient.GetBlocks(peerID, localLatest+1, s.blockTimeout)
+	if err != nil {
+		return nil, err
+	}
+
+	// Create a blockchain subscription for the sync progression and start tracking
+	subscription := s.blockchain.SubscribeEvents()
+	s.syncProgression.StartProgression(localLatest+1, subscription)
+	s.syncProgression.UpdateHighestProgression(pivot)
+
+	defer func() {
+		err := s.syncPeerClient.CloseStream(peerID)
+		if err != nil {
+			s.logger.Error("Failed to close stream: ", err)
+		}
+
+		// Stop monitoring the sync progression upon exit
+		s.syncProgression.StopProgression()
+		s.blockchain.UnsubscribeEvents(subscription)
+	}()
+
+	for {
+		select {
+		case block, ok := <-blockCh:
+			if !ok {
+				return nil, errPivotNotFound
+			}
+
+			// safe check
+			if block.Number() == 0 {
+				continue
+			}
+
+			if err := s.blockchain.VerifyFinalizedBlockHeader(block); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+				return nil, fmt.Errorf("unable to verify block, %w", err)
+			}
+
+			fullBlock := &types.FullBlock{Block: block}
+
+			if err := s.blockchain.WriteFullBlock(fullBlock, syncerName); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_block"}, 1)
+
+				return nil, fmt.Errorf("failed to write block while header syncing: %w", err)
+			}
+
+			updateMetrics(fullBlock)
+
+			if block.Number() == pivot {
+				return block, nil
+			}
+		case <-time.After(s.blockTimeout):
+			return nil, errTimeout
+		}
+	}
+}
+
+// syncStateWithPeer downloads the missing trie nodes and contract codes of the state
+// with the given root from a given peer, verifying them against their hashes
+func (s *syncer) syncStateWithPeer(peerID peer.ID, root types.Hash) error {
+	stateSync, err := itrie.NewStateSync(s.stateStorage, root)
+	if err != nil {
+		return err
+	}
+
+	for stateSync.Pending() > 0 {
+		nodeHashes, codeHashes := stateSync.Missing(maxStateNodesPerRequest, maxStateCodesPerRequest)
+
+		nodes, codes, err := s.syncPeerClient.GetStateData(peerID, nodeHashes, codeHashes)
+		if err != nil {
+			return err
+		}
+
+		if len(nodes) == 0 && len(codes) == 0 {
+			return errNoStateData
+		}
+
+		for _, node := range nodes {
+			if err := stateSync.ProcessNode(node); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_state_data"}, 1)
+
+				return err
+			}
+		}
+
+		for _, code := range codes {
+			if err := stateSync.ProcessCode(code); err != nil {
+				metrics.IncrCounter([]string{syncerMetrics, "bad_state_data"}, 1)
+
+				return err
+			}
+		}
+
+		s.logger.Debug("state data received", "nodes", len(nodes), "codes", len(codes), "pending", stateSync.Pending())
+	}
+
+	return nil
+}
+
 func updateMetrics(fullBlock *types.FullBlock) {
 	metrics.SetGauge([]string{syncerMetrics, "tx_num"}, float32(len(fullBlock.Block.Transactions)))
 	metrics.SetGauge([]string{syncerMetrics, "receipts_num"}, float32(len(fullBlock.Receipts)))
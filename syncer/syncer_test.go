@@ -9,12 +9,16 @@ import (
 	"time"
 
 	"github.com/0xPolygon/polygon-edge/blockchain"
+	"github.com/0xPolygon/polygon-edge/crypto"
 	"github.com/0xPolygon/polygon-edge/helper/progress"
 	"github.com/0xPolygon/polygon-edge/network/event"
+	"github.com/0xPolygon/polygon-edge/state"
+	itrie "github.com/0xPolygon/polygon-edge/state/immutable-trie"
 	"github.com/0xPolygon/polygon-edge/types"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 )
 
 type mockProgression struct {
@@ -36,12 +40,14 @@ func (m *mockProgression) GetProgression() *progress.Progression {
 }
 
 type mockBlockchain struct {
-	subscription                blockchain.Subscription
-	headerHandler               func() *types.Header
-	getBlockByNumberHandler     func(uint64, bool) (*types.Block, bool)
-	verifyFinalizedBlockHandler func(*types.Block) (*types.FullBlock, error)
-	writeBlockHandler           func(*types.Block) error
-	writeFullBlockHandler       func(*types.FullBlock) error
+	subscription                 blockchain.Subscription
+	headerHandler                func() *types.Header
+	getBlockByNumberHandler      func(uint64, bool) (*types.Block, bool)
+	verifyFinalizedBlockHandler  func(*types.Block) (*types.FullBlock, error)
+	verifyFinalizedHeaderHandler func(*types.Block) error
+	writeBlockHandler            func(*types.Block) error
+	writeFullBlockHandler        func(*types.FullBlock) error
+	firstReceiptsNumber          uint64
 }
 
 func (m *mockBlockchain) SubscribeEvents() blockchain.Subscription {
@@ -63,6 +69,16 @@ func (m *mockBlockchain) VerifyFinalizedBlock(b *types.Block) (*types.FullBlock,
 	return m.verifyFinalizedBlockHandler(b)
 }
 
+func (m *mockBlockchain) VerifyFinalizedBlockHeader(b *types.Block) error {
+	return m.verifyFinalizedHeaderHandler(b)
+}
+
+func (m *mockBlockchain) SetFirstReceiptsNumber(number uint64) error {
+	m.firstReceiptsNumber = number
+
+	return nil
+}
+
 func (m *mockBlockchain) WriteBlock(b *types.Block, s string) error {
 	return m.writeBlockHandler(b)
 }
@@ -93,6 +109,7 @@ type mockSyncPeerClient struct {
 	getPeerStatusHandler                  func(peer.ID) (*NoForkPeer, error)
 	getConnectedPeerStatusesHandler       func() []*NoForkPeer
 	getBlocksHandler                      func(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	getStateDataHandler                   func(peer.ID, []types.Hash, []types.Hash) ([][]byte, [][]byte, error)
 	getPeerStatusUpdateChHandler          func() <-chan *NoForkPeer
 	getPeerConnectionUpdateEventChHandler func() <-chan *event.PeerEvent
 }
@@ -123,6 +140,14 @@ func (m *mockSyncPeerClient) GetBlocks(
 	return m.getBlocksHandler(id, start, timeoutPerBlock)
 }
 
+func (m *mockSyncPeerClient) GetStateData(
+	id peer.ID,
+	nodeHashes []types.Hash,
+	codeHashes []types.Hash,
+) ([][]byte, [][]byte, error) {
+	return m.getStateDataHandler(id, nodeHashes, codeHashes)
+}
+
 func (m *mockSyncPeerClient) GetPeerStatusUpdateCh() <-chan *NoForkPeer {
 	return m.getPeerStatusUpdateChHandler()
 }
@@ -858,3 +883,292 @@ func Test_bulkSyncWithPeer(t *testing.T) {
 		})
 	}
 }
+
+// createTestState commits a state of the accounts with code into the storage and returns its root
+func createTestState(t *testing.T, storage itrie.Storage) types.Hash {
+	t.Helper()
+
+	code := []byte{0x60, 0x01}
+	codeHash := types.BytesToHash(crypto.Keccak256(code))
+
+	st := itrie.NewState(storage)
+	require.NoError(t, st.SetCode(codeHash, code))
+
+	objs := make([]*state.Object, 0, 20)
+
+	for i := 1; i <= 20; i++ {
+		objs = append(objs, &state.Object{
+			Address:  types.BytesToAddress(big.NewInt(int64(i)).Bytes()),
+			Balance:  big.NewInt(int64(i)),
+			CodeHash: codeHash,
+			Root:     types.EmptyRootHash,
+		})
+	}
+
+	_, root, err := st.NewSnapshot().Commit(objs)
+	require.NoError(t, err)
+
+	return types.BytesToHash(root)
+}
+
+// newStateDataHandler returns a handler serving the state data from the given storage
+func newStateDataHandler(source itrie.Storage) func(peer.ID, []types.Hash, []types.Hash) ([][]byte, [][]byte, error) {
+	return func(_ peer.ID, nodeHashes []types.Hash, codeHashes []types.Hash) ([][]byte, [][]byte, error) {
+		nodes := make([][]byte, 0, len(nodeHashes))
+		codes := make([][]byte, 0, len(codeHashes))
+
+		for _, hash := range nodeHashes {
+			if node, ok, _ := source.Get(hash.Bytes()); ok {
+				nodes = append(nodes, node)
+			}
+		}
+
+		for _, hash := range codeHashes {
+			if code, ok := source.GetCode(hash); ok {
+				codes = append(codes, code)
+			}
+		}
+
+		return nodes, codes, nil
+	}
+}
+
+func TestSync_StateSync(t *testing.T) {
+	t.Parallel()
+
+	const peerLatest = 100
+
+	source := itrie.NewMemoryStorage()
+	target := itrie.NewMemoryStorage()
+	root := createTestState(t, source)
+
+	pivot := uint64(peerLatest - statePivotOffset)
+
+	blocks := createMockBlocks(peerLatest)
+	blocks[pivot-1].Header.StateRoot = root
+
+	var (
+		latestHeader    = &types.Header{Number: 0}
+		verifiedHeaders = make([]uint64, 0)
+		executedBlocks  = make([]uint64, 0)
+		notifiedBlocks  = make([]uint64, 0)
+	)
+
+	chain := &mockBlockchain{
+		headerHandler: func() *types.Header {
+			return latestHeader
+		},
+		verifyFinalizedHeaderHandler: func(b *types.Block) error {
+			verifiedHeaders = append(verifiedHeaders, b.Number())
+
+			return nil
+		},
+		verifyFinalizedBlockHandler: func(b *types.Block) (*types.FullBlock, error) {
+			executedBlocks = append(executedBlocks, b.Number())
+
+			return &types.FullBlock{Block: b}, nil
+		},
+		writeFullBlockHandler: func(b *types.FullBlock) error {
+			latestHeader = b.Block.Header
+
+			return nil
+		},
+	}
+
+	syncer := NewTestSyncer(
+		nil,
+		chain,
+		time.Second,
+		&mockSyncPeerClient{
+			getBlocksHandler: func(_ peer.ID, from uint64, _ time.Duration) (<-chan *types.Block, error) {
+				return blocksToCh(blocks[from-1:], 0), nil
+			},
+			getStateDataHandler: newStateDataHandler(source),
+		},
+		&mockProgression{},
+	)
+
+	syncer.syncMode = StateSyncMode
+	syncer.stateStorage = target
+
+	errCh := make(chan error, 1)
+
+	go func() {
+		errCh <- syncer.Sync(func(b *types.FullBlock) bool {
+			notifiedBlocks = append(notifiedBlocks, b.Block.Number())
+
+			return b.Block.Number() >= peerLatest
+		})
+	}()
+
+	syncer.peerMap.Put(&NoForkPeer{ID: peer.ID("A"), Number: peerLatest, Distance: big.NewInt(0)})
+	syncer.newStatusCh <- struct{}{}
+
+	require.NoError(t, <-errCh)
+
+	numbers := func(from, to uint64) []uint64 {
+		res := make([]uint64, 0, to-from+1)
+		for i := from; i <= to; i++ {
+			res = append(res, i)
+		}
+
+		return res
+	}
+
+	// the blocks up to the pivot are not executed, and the consensus is notified from the pivot
+	assert.Equal(t, numbers(1, pivot), verifiedHeaders)
+	assert.Equal(t, numbers(pivot+1, peerLatest), executedBlocks)
+	assert.Equal(t, numbers(pivot, peerLatest), notifiedBlocks)
+
+	// the receipts are available only for the executed blocks
+	assert.Equal(t, pivot+1, chain.firstReceiptsNumber)
+
+	snap, err := itrie.NewState(target).NewSnapshotAt(root)
+	require.NoError(t, err)
+
+	account, err := snap.GetAccount(types.BytesToAddress(big.NewInt(7).Bytes()))
+	require.NoError(t, err)
+	assert.Equal(t, big.NewInt(7), account.Balance)
+
+	code, ok := snap.GetCode(types.BytesToHash(account.CodeHash))
+	assert.True(t, ok)
+	assert.Equal(t, []byte{0x60, 0x01}, code)
+}
+
+func Test_statePivot(t *testing.T) {
+	t.Parallel()
+
+	storage := itrie.NewMemoryStorage()
+	root := createTestState(t, storage)
+
+	tests := []struct {
+		name       string
+		syncMode   SyncMode
+		header     *types.Header
+		peerLatest uint64
+		pivot      uint64
+		ok         bool
+	}{
+		{
+			name:       "full sync mode",
+			syncMode:   FullSyncMode,
+			header:     &types.Header{Number: 0},
+			peerLatest: 1000,
+		},
+		{
+			name:       "fresh node",
+			syncMode:   StateSyncMode,
+			header:     &types.Header{Number: 0},
+			peerLatest: 1000,
+			pivot:      1000 - statePivotOffset,
+			ok:         true,
+		},
+		{
+			name:       "fresh node close to the peer",
+			syncMode:   StateSyncMode,
+			header:     &types.Header{Number: 0},
+			peerLatest: statePivotOffset,
+		},
+		{
+			name:       "node with the latest state",
+			syncMode:   StateSyncMode,
+			header:     &types.Header{Number: 10, StateRoot: root},
+			peerLatest: 1000,
+		},
+		{
+			name:       "interrupted state sync",
+			syncMode:   StateSyncMode,
+			header:     &types.Header{Number: 10, StateRoot: types.StringToHash("0x1")},
+			peerLatest: 20,
+			pivot:      10,
+			ok:         true,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			syncer := NewTestSyncer(
+				nil,
+				&mockBlockchain{
+					headerHandler: func() *types.Header {
+						return test.header
+					},
+				},
+				time.Second,
+				&mockSyncPeerClient{},
+				&mockProgression{},
+			)
+
+			syncer.syncMode = test.syncMode
+			syncer.stateStorage = storage
+
+			pivot, ok := syncer.statePivot(test.peerLatest)
+
+			assert.Equal(t, test.pivot, pivot)
+			assert.Equal(t, test.ok, ok)
+		})
+	}
+}
+
+func Test_syncStateWithPeer(t *testing.T) {
+	t.Parallel()
+
+	source := itrie.NewMemoryStorage()
+	root := createTestState(t, source)
+
+	tests := []struct {
+		name                string
+		getStateDataHandler func(peer.ID, []types.Hash, []types.Hash) ([][]byte, [][]byte, error)
+		err                 error
+	}{
+		{
+			name:                "should sync state successfully",
+			getStateDataHandler: newStateDataHandler(source),
+		},
+		{
+			name:                "should return error if peer has no state",
+			getStateDataHandler: newStateDataHandler(itrie.NewMemoryStorage()),
+			err:                 errNoStateData,
+		},
+		{
+			name: "should return error if peer returns unrequested data",
+			getStateDataHandler: func(peer.ID, []types.Hash, []types.Hash) ([][]byte, [][]byte, error) {
+				return [][]byte{{0xc0}}, nil, nil
+			},
+			err: itrie.ErrUnexpectedStateData,
+		},
+	}
+
+	for _, test := range tests {
+		test := test
+
+		t.Run(test.name, func(t *testing.T) {
+			t.Parallel()
+
+			target := itrie.NewMemoryStorage()
+
+			syncer := NewTestSyncer(
+				nil,
+				&mockBlockchain{},
+				time.Second,
+				&mockSyncPeerClient{
+					getStateDataHandler: test.getStateDataHandler,
+				},
+				&mockProgression{},
+			)
+
+			syncer.stateStorage = target
+
+			err := syncer.syncStateWithPeer(peer.ID("A"), root)
+			assert.ErrorIs(t, err, test.err)
+
+			// the root is stored only when the whole state is synced
+			_, ok, _ := target.Get(root.Bytes())
+			assert.Equal(t, test.err == nil, ok)
+		})
+	}
+}
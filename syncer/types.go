@@ -18,6 +18,17 @@ import (
 
 const syncerMetrics = "syncer"
 
+// SyncMode defines how the syncer brings a node up to date with its peers
+type SyncMode string
+
+const (
+	// FullSyncMode downloads and executes all the blocks from the genesis
+	FullSyncMode SyncMode = "full"
+	// StateSyncMode downloads the state of a recent (pivot) block instead of executing
+	// the blocks preceding it, and executes only the blocks following the pivot
+	StateSyncMode SyncMode = "state"
+)
+
 type Blockchain interface {
 	// SubscribeEvents subscribes new blockchain event
 	SubscribeEvents() blockchain.Subscription
@@ -29,6 +40,10 @@ type Blockchain interface {
 	GetBlockByNumber(uint64, bool) (*types.Block, bool)
 	// VerifyFinalizedBlock verifies finalized block
 	VerifyFinalizedBlock(block *types.Block) (*types.FullBlock, error)
+	// VerifyFinalizedBlockHeader verifies finalized block without executing its transactions
+	VerifyFinalizedBlockHeader(block *types.Block) error
+	// SetFirstReceiptsNumber records that the blocks preceding the given one are written without their receipts
+	SetFirstReceiptsNumber(number uint64) error
 	// WriteBlock writes a given block to chain
 	WriteBlock(*types.Block, string) error
 	// WriteFullBlock writes a given block to chain and saves its receipts to cache
@@ -101,6 +116,8 @@ type SyncPeerClient interface {
 	GetConnectedPeerStatuses() []*NoForkPeer
 	// GetBlocks returns a stream of blocks from given height to peer's latest
 	GetBlocks(peer.ID, uint64, time.Duration) (<-chan *types.Block, error)
+	// GetStateData fetches the trie nodes and contract codes with given hashes from peer
+	GetStateData(peer.ID, []types.Hash, []types.Hash) ([][]byte, [][]byte, error)
 	// GetPeerStatusUpdateCh returns a channel of peer's status update
 	GetPeerStatusUpdateCh() <-chan *NoForkPeer
 	// GetPeerConnectionUpdateEventCh returns peer's connection change event
@@ -50,6 +50,8 @@ func TestState(t *testing.T) {
 		"RevertPrecompiledTouch_storage",
 		"loopMul",
 		"CALLBlake2f_MaxRounds",
+		// Cancun blob transactions which are not supported
+		"stEIP4844-blobtransactions",
 	}
 
 	files, err := listFiles(stateTestsDir, ".json")
@@ -1,6 +1,7 @@
 package txpool
 
 import (
+	"sort"
 	"sync"
 	"sync/atomic"
 
@@ -348,6 +349,73 @@ func (a *account) promote() (promoted []*types.Transaction, pruned []*types.Tran
 	return
 }
 
+// evictable returns the transactions which can be evicted from the account in the eviction order,
+// i.e. from the highest nonce, so that the eviction never leaves a nonce gap.
+// The promoted transactions follow the enqueued ones unless only the enqueued are requested
+func (a *account) evictable(enqueuedOnly bool) []*types.Transaction {
+	a.promoted.lock(false)
+	a.enqueued.lock(false)
+
+	defer func() {
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	txs := make([]*types.Transaction, 0, a.enqueued.length()+a.promoted.length())
+	txs = append(txs, sortedByNonceDesc(a.enqueued.queue)...)
+
+	if !enqueuedOnly {
+		txs = append(txs, sortedByNonceDesc(a.promoted.queue)...)
+	}
+
+	return txs
+}
+
+// evict removes the transaction if it has the highest nonce of the account.
+// An evicted promoted transaction rolls the account nonce back to its own nonce.
+// Returns whether the transaction has been removed and whether it was promoted
+func (a *account) evict(tx *types.Transaction) (evicted bool, promoted bool) {
+	a.promoted.lock(true)
+	a.enqueued.lock(true)
+	a.nonceToTx.lock()
+
+	defer func() {
+		a.nonceToTx.unlock()
+		a.enqueued.unlock()
+		a.promoted.unlock()
+	}()
+
+	if a.enqueued.length() > 0 {
+		if !a.enqueued.removeHighest(tx) {
+			return false, false
+		}
+	} else {
+		if !a.promoted.removeHighest(tx) {
+			return false, false
+		}
+
+		a.setNonce(tx.Nonce())
+
+		promoted = true
+	}
+
+	a.nonceToTx.remove(tx)
+
+	return true, promoted
+}
+
+// sortedByNonceDesc returns a copy of the transactions sorted by nonce (descending)
+func sortedByNonceDesc(txs []*types.Transaction) []*types.Transaction {
+	sorted := make([]*types.Transaction, len(txs))
+	copy(sorted, txs)
+
+	sort.Slice(sorted, func(i, j int) bool {
+		return sorted[i].Nonce() > sorted[j].Nonce()
+	})
+
+	return sorted
+}
+
 // resetSkips sets 0 to skips
 func (a *account) resetSkips() {
 	atomic.StoreUint64(&a.skips, 0)
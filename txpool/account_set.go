@@ -0,0 +1,48 @@
+package txpool
+
+import (
+	"sync"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// Thread safe set of account addresses
+type accountSet struct {
+	sync.RWMutex
+	accounts map[types.Address]struct{}
+}
+
+func newAccountSet() *accountSet {
+	return &accountSet{accounts: make(map[types.Address]struct{})}
+}
+
+// add inserts the address into the set
+func (s *accountSet) add(addr types.Address) {
+	s.Lock()
+	defer s.Unlock()
+
+	s.accounts[addr] = struct{}{}
+}
+
+// contains returns true if the address is in the set
+func (s *accountSet) contains(addr types.Address) bool {
+	s.RLock()
+	defer s.RUnlock()
+
+	_, ok := s.accounts[addr]
+
+	return ok
+}
+
+// list returns the addresses of the set
+func (s *accountSet) list() []types.Address {
+	s.RLock()
+	defer s.RUnlock()
+
+	addrs := make([]types.Address, 0, len(s.accounts))
+	for addr := range s.accounts {
+		addrs = append(addrs, addr)
+	}
+
+	return addrs
+}
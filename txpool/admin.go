@@ -0,0 +1,70 @@
+package txpool
+
+import (
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+/* ADMIN methods */
+// Used by the operators to clean up the stuck transactions without restarting the node.
+
+// RemoveTx removes the transaction with the given hash from the pool, along with the transactions
+// of the same account with the higher nonces, which can't be executed without it.
+// A removed promoted transaction rolls the account nonce back to its own nonce.
+// Returns the number of the removed transactions
+func (p *TxPool) RemoveTx(hash types.Hash) int {
+	return p.removeTx(hash, reasonAdminRemoved)
+}
+
+// removeTx removes the transaction with the given hash and the higher nonces of its account,
+// the reason is recorded in the lifecycle of the removed transactions.
+// Removing the lowest transaction of the account clears the whole account, so it is dropped,
+// otherwise the transactions are evicted one by one to keep the lower nonces
+func (p *TxPool) removeTx(hash types.Hash, reason string) int {
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return 0
+	}
+
+	account := p.accounts.get(tx.From())
+
+	if lowest := account.getLowestTx(); lowest != nil && lowest.Nonce() >= tx.Nonce() {
+		// an enqueued transaction doesn't move the next nonce of the account
+		return p.dropAccount(account, min(account.getNonce(), tx.Nonce()), tx, reason)
+	}
+
+	removed := 0
+
+	// the account transactions are removed from the highest nonce, so no nonce gap is left behind
+	for _, candidate := range account.evictable(false) {
+		if candidate.Nonce() < tx.Nonce() || !p.evictTx(candidate, reason) {
+			break
+		}
+
+		removed++
+	}
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("removed tx", "hash", hash.String(), "num", removed)
+	}
+
+	return removed
+}
+
+// DropAccount clears all the transactions of the given account
+// and reverts its next (expected) nonce to the one from the state.
+// Returns the number of the dropped transactions
+func (p *TxPool) DropAccount(addr types.Address) int {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return 0
+	}
+
+	firstTx := account.getLowestTx()
+	if firstTx == nil {
+		return 0
+	}
+
+	nextNonce := p.store.GetNonce(p.store.Header().StateRoot, addr)
+
+	return p.dropAccount(account, nextNonce, firstTx, reasonAdminDropped)
+}
@@ -0,0 +1,139 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestRemoveTx(t *testing.T) {
+	t.Parallel()
+
+	// addAccountTxs adds txs with nonces 0..4 to the account, the first three of them are promoted
+	addAccountTxs := func(t *testing.T, pool *TxPool) []*types.Transaction {
+		t.Helper()
+
+		txs := make([]*types.Transaction, 5)
+
+		for nonce := range txs {
+			txs[nonce] = newPricedTx(addr1, uint64(nonce), 2)
+
+			if nonce == 3 {
+				pool.handlePromoteRequest(promoteRequest{account: addr1})
+			}
+
+			require.NoError(t, pool.addTx(gossip, txs[nonce]))
+		}
+
+		require.Equal(t, uint64(3), pool.accounts.get(addr1).promoted.length())
+		require.Equal(t, uint64(2), pool.accounts.get(addr1).enqueued.length())
+
+		return txs
+	}
+
+	t.Run("removes the promoted tx and the higher nonces", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+		txs := addAccountTxs(t, pool)
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 2)))
+
+		require.Equal(t, 4, pool.RemoveTx(txs[1].Hash()))
+
+		acc := pool.accounts.get(addr1)
+		require.Equal(t, uint64(1), acc.promoted.length())
+		require.Equal(t, uint64(0), acc.enqueued.length())
+		require.Equal(t, uint64(1), acc.getNonce())
+		require.Equal(t, uint64(2), pool.gauge.read())
+		require.Len(t, pool.index.all, 2)
+
+		_, ok := pool.index.get(txs[0].Hash())
+		require.True(t, ok)
+	})
+
+	t.Run("removes only the enqueued txs", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+		txs := addAccountTxs(t, pool)
+
+		require.Equal(t, 1, pool.RemoveTx(txs[4].Hash()))
+
+		acc := pool.accounts.get(addr1)
+		require.Equal(t, uint64(3), acc.promoted.length())
+		require.Equal(t, uint64(1), acc.enqueued.length())
+		require.Equal(t, uint64(3), acc.getNonce())
+	})
+
+	t.Run("removing the lowest tx drops the account", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+		txs := addAccountTxs(t, pool)
+
+		require.Equal(t, 5, pool.RemoveTx(txs[0].Hash()))
+
+		acc := pool.accounts.get(addr1)
+		require.Equal(t, uint64(0), acc.promoted.length())
+		require.Equal(t, uint64(0), acc.enqueued.length())
+		require.Equal(t, uint64(0), acc.getNonce())
+		require.Equal(t, uint64(0), pool.gauge.read())
+		require.Empty(t, pool.index.all)
+	})
+
+	t.Run("removing the lowest enqueued tx keeps the next nonce", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+
+		tx := newPricedTx(addr1, 3, 2)
+		require.NoError(t, pool.addTx(gossip, tx))
+
+		require.Equal(t, 1, pool.RemoveTx(tx.Hash()))
+
+		acc := pool.accounts.get(addr1)
+		require.Equal(t, uint64(0), acc.enqueued.length())
+		require.Equal(t, uint64(0), acc.getNonce())
+	})
+
+	t.Run("unknown tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+
+		require.Equal(t, 0, pool.RemoveTx(types.StringToHash("0x1")))
+	})
+}
+
+func TestDropAccount(t *testing.T) {
+	t.Parallel()
+
+	pool := newEvictionTestPool(t, 10)
+
+	for nonce := uint64(0); nonce < 3; nonce++ {
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, nonce, 2)))
+	}
+
+	pool.handlePromoteRequest(promoteRequest{account: addr1})
+	require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, 5, 2)))
+	require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 2)))
+
+	require.Equal(t, 4, pool.DropAccount(addr1))
+
+	acc := pool.accounts.get(addr1)
+	require.Equal(t, uint64(0), acc.promoted.length())
+	require.Equal(t, uint64(0), acc.enqueued.length())
+	require.Equal(t, uint64(0), acc.getNonce())
+	require.Equal(t, uint64(1), pool.gauge.read())
+	require.Len(t, pool.index.all, 1)
+
+	promoted, enqueued := pool.GetAccountTxs(addr1)
+	require.Empty(t, promoted)
+	require.Empty(t, enqueued)
+
+	require.Equal(t, 0, pool.DropAccount(addr1))
+	require.Equal(t, 0, pool.DropAccount(addr3))
+}
@@ -0,0 +1,163 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// maxBundleTxs is the maximum number of transactions in a single bundle
+	maxBundleTxs = 16
+
+	// maxBundles is the maximum number of bundles waiting for their target block
+	maxBundles = 1024
+)
+
+var (
+	ErrEmptyBundle             = errors.New("bundle has no transactions")
+	ErrBundleTooLarge          = fmt.Errorf("bundle has more than %d transactions", maxBundleTxs)
+	ErrBundleExpired           = errors.New("bundle target block is already built")
+	ErrInvalidBundleTimestamps = errors.New("bundle min timestamp is greater than its max timestamp")
+	ErrBundleQueueFull         = errors.New("bundle queue is full")
+	ErrBundleNotValidator      = errors.New("bundles are accepted by the validators only")
+)
+
+// bundleQueue keeps the bundles in the submission order until their target block is built
+type bundleQueue struct {
+	sync.Mutex
+
+	bundles []*types.Bundle
+	hashes  map[types.Hash]*types.Bundle
+}
+
+func newBundleQueue() *bundleQueue {
+	return &bundleQueue{
+		hashes: make(map[types.Hash]*types.Bundle),
+	}
+}
+
+// add appends the bundle to the queue
+func (q *bundleQueue) add(hash types.Hash, bundle *types.Bundle) error {
+	q.Lock()
+	defer q.Unlock()
+
+	if _, ok := q.hashes[hash]; ok {
+		return ErrAlreadyKnown
+	}
+
+	if len(q.bundles) >= maxBundles {
+		return ErrBundleQueueFull
+	}
+
+	q.bundles = append(q.bundles, bundle)
+	q.hashes[hash] = bundle
+
+	return nil
+}
+
+// includable returns the bundles which can be included in the block with the given number and timestamp
+func (q *bundleQueue) includable(number, timestamp uint64) []*types.Bundle {
+	q.Lock()
+	defer q.Unlock()
+
+	var bundles []*types.Bundle
+
+	for _, bundle := range q.bundles {
+		if bundle.IncludableIn(number, timestamp) {
+			bundles = append(bundles, bundle)
+		}
+	}
+
+	return bundles
+}
+
+// prune removes the bundles targeting the blocks up to the given number (inclusive)
+func (q *bundleQueue) prune(number uint64) {
+	q.Lock()
+	defer q.Unlock()
+
+	kept := q.bundles[:0]
+
+	for _, bundle := range q.bundles {
+		if bundle.BlockNumber > number {
+			kept = append(kept, bundle)
+		}
+	}
+
+	for hash, bundle := range q.hashes {
+		if bundle.BlockNumber <= number {
+			delete(q.hashes, hash)
+		}
+	}
+
+	for i := len(kept); i < len(q.bundles); i++ {
+		q.bundles[i] = nil
+	}
+
+	q.bundles = kept
+}
+
+// length returns the number of the queued bundles
+func (q *bundleQueue) length() int {
+	q.Lock()
+	defer q.Unlock()
+
+	return len(q.bundles)
+}
+
+// AddBundle validates the bundle transactions and queues the bundle for its target block.
+// The bundle transactions are not added to the pool. Returns the hash of the bundle.
+// The bundles are not gossiped, so they are accepted by the validators only, and a bundle
+// is included only if the validator it has been sent to proposes the target block
+func (p *TxPool) AddBundle(bundle *types.Bundle) (types.Hash, error) {
+	if !p.sealing.Load() {
+		return types.ZeroHash, ErrBundleNotValidator
+	}
+
+	if len(bundle.Txs) == 0 {
+		return types.ZeroHash, ErrEmptyBundle
+	}
+
+	if len(bundle.Txs) > maxBundleTxs {
+		return types.ZeroHash, ErrBundleTooLarge
+	}
+
+	if bundle.BlockNumber <= p.store.Header().Number {
+		return types.ZeroHash, ErrBundleExpired
+	}
+
+	if bundle.MaxTimestamp != 0 && bundle.MinTimestamp > bundle.MaxTimestamp {
+		return types.ZeroHash, ErrInvalidBundleTimestamps
+	}
+
+	for _, tx := range bundle.Txs {
+		tx.ComputeHash()
+
+		if err := p.validateTx(tx); err != nil {
+			return types.ZeroHash, fmt.Errorf("invalid bundle tx %s: %w", tx.Hash(), err)
+		}
+	}
+
+	hash := bundle.Hash()
+
+	if err := p.bundles.add(hash, bundle); err != nil {
+		return types.ZeroHash, err
+	}
+
+	metrics.SetGauge([]string{txPoolMetrics, "bundles"}, float32(p.bundles.length()))
+
+	p.logger.Debug("bundle added", "hash", hash, "block", bundle.BlockNumber, "txs", len(bundle.Txs))
+
+	return hash, nil
+}
+
+// Bundles returns the bundles which can be included in the block with the given number and timestamp,
+// in the submission order
+func (p *TxPool) Bundles(number, timestamp uint64) []*types.Bundle {
+	return p.bundles.includable(number, timestamp)
+}
@@ -0,0 +1,109 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestAddBundle(t *testing.T) {
+	t.Parallel()
+
+	newBundle := func(blockNumber uint64, txs ...*types.Transaction) *types.Bundle {
+		return &types.Bundle{Txs: txs, BlockNumber: blockNumber}
+	}
+
+	t.Run("valid bundle is queued", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newGossipTestPool(t)
+
+		bundle := newBundle(1,
+			newTx(addr1, 0, 1, types.LegacyTxType),
+			newTx(addr1, 1, 1, types.LegacyTxType),
+		)
+
+		hash, err := pool.AddBundle(bundle)
+		require.NoError(t, err)
+		require.Equal(t, bundle.Hash(), hash)
+
+		// the bundle txs are not added to the pool
+		require.Equal(t, uint64(0), pool.Length())
+		require.Equal(t, []*types.Bundle{bundle}, pool.Bundles(1, 0))
+
+		_, err = pool.AddBundle(bundle)
+		require.ErrorIs(t, err, ErrAlreadyKnown)
+	})
+
+	t.Run("invalid bundles are rejected", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newGossipTestPool(t)
+
+		_, err := pool.AddBundle(newBundle(1))
+		require.ErrorIs(t, err, ErrEmptyBundle)
+
+		txs := make([]*types.Transaction, maxBundleTxs+1)
+		for i := range txs {
+			txs[i] = newTx(addr1, uint64(i), 1, types.LegacyTxType)
+		}
+
+		_, err = pool.AddBundle(newBundle(1, txs...))
+		require.ErrorIs(t, err, ErrBundleTooLarge)
+
+		_, err = pool.AddBundle(newBundle(0, newTx(addr1, 0, 1, types.LegacyTxType)))
+		require.ErrorIs(t, err, ErrBundleExpired)
+
+		bundle := newBundle(1, newTx(addr1, 0, 1, types.LegacyTxType))
+		bundle.MinTimestamp = 20
+		bundle.MaxTimestamp = 10
+
+		_, err = pool.AddBundle(bundle)
+		require.ErrorIs(t, err, ErrInvalidBundleTimestamps)
+
+		pool.SetSealing(false)
+
+		_, err = pool.AddBundle(newBundle(1, newTx(addr1, 0, 1, types.LegacyTxType)))
+		require.ErrorIs(t, err, ErrBundleNotValidator)
+
+		pool.SetSealing(true)
+
+		tx := newTx(addr1, 0, 1, types.LegacyTxType)
+		tx.SetGas(1)
+
+		_, err = pool.AddBundle(newBundle(1, tx))
+		require.ErrorIs(t, err, ErrIntrinsicGas)
+
+		require.Equal(t, 0, pool.bundles.length())
+	})
+}
+
+func TestBundleQueue(t *testing.T) {
+	t.Parallel()
+
+	queue := newBundleQueue()
+
+	bundles := []*types.Bundle{
+		{BlockNumber: 1},
+		{BlockNumber: 2, MinTimestamp: 10},
+		{BlockNumber: 2, MaxTimestamp: 10},
+		{BlockNumber: 3},
+	}
+
+	for i, bundle := range bundles {
+		require.NoError(t, queue.add(types.Hash{byte(i)}, bundle))
+	}
+
+	require.Equal(t, []*types.Bundle{bundles[0]}, queue.includable(1, 5))
+	require.Equal(t, []*types.Bundle{bundles[2]}, queue.includable(2, 5))
+	require.Equal(t, []*types.Bundle{bundles[1], bundles[2]}, queue.includable(2, 10))
+	require.Equal(t, []*types.Bundle{bundles[1]}, queue.includable(2, 15))
+
+	queue.prune(2)
+
+	require.Equal(t, 1, queue.length())
+	require.Len(t, queue.hashes, 1)
+	require.Equal(t, []*types.Bundle{bundles[3]}, queue.includable(3, 0))
+}
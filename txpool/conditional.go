@@ -0,0 +1,198 @@
+package txpool
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// maxConditionSlots is the maximum number of the storage slots a conditional transaction can expect values of
+	maxConditionSlots = 1000
+
+	// reasonConditionsFailed is the reason of the conditional transactions leaving the pool
+	reasonConditionsFailed = "transaction conditions no longer hold"
+)
+
+var (
+	ErrTooManyConditionSlots = fmt.Errorf("transaction conditions expect more than %d storage slots", maxConditionSlots)
+	ErrInvalidTxConditions   = errors.New("transaction conditions min bound is greater than its max bound")
+)
+
+// conditionsMap keeps the conditions of the conditional transactions in the pool
+type conditionsMap struct {
+	sync.RWMutex
+
+	conditions map[types.Hash]*types.TxConditions
+}
+
+func newConditionsMap() *conditionsMap {
+	return &conditionsMap{
+		conditions: make(map[types.Hash]*types.TxConditions),
+	}
+}
+
+func (m *conditionsMap) set(hash types.Hash, conditions *types.TxConditions) {
+	m.Lock()
+	defer m.Unlock()
+
+	m.conditions[hash] = conditions
+}
+
+func (m *conditionsMap) get(hash types.Hash) *types.TxConditions {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.conditions[hash]
+}
+
+func (m *conditionsMap) remove(hash types.Hash) {
+	m.Lock()
+	defer m.Unlock()
+
+	delete(m.conditions, hash)
+}
+
+// all returns a copy of the conditions map
+func (m *conditionsMap) all() map[types.Hash]*types.TxConditions {
+	m.RLock()
+	defer m.RUnlock()
+
+	all := make(map[types.Hash]*types.TxConditions, len(m.conditions))
+	for hash, conditions := range m.conditions {
+		all[hash] = conditions
+	}
+
+	return all
+}
+
+// AddConditionalTx adds a new local transaction to the pool, which can only be included
+// in a block while the given conditions hold. The conditions are checked against the head state.
+// Conditional transactions are neither broadcasted nor journaled, as their conditions are only known to this node
+func (p *TxPool) AddConditionalTx(tx *types.Transaction, conditions *types.TxConditions) error {
+	if conditions.SlotsCount() > maxConditionSlots {
+		return ErrTooManyConditionSlots
+	}
+
+	if (conditions.BlockNumberMax != 0 && conditions.BlockNumberMin > conditions.BlockNumberMax) ||
+		(conditions.TimestampMax != 0 && conditions.TimestampMin > conditions.TimestampMax) {
+		return ErrInvalidTxConditions
+	}
+
+	if err := p.checkConditions(p.store.Header(), conditions); err != nil {
+		return err
+	}
+
+	// the conditions are set beforehand, so the transaction is never served to the block builder without them
+	hash := tx.ComputeHash().Hash()
+	p.conditionals.set(hash, conditions)
+
+	if err := p.addTx(local, tx); err != nil {
+		p.conditionals.remove(hash)
+		p.logger.Error("failed to add conditional tx", "err", err)
+
+		return err
+	}
+
+	metrics.IncrCounter([]string{txPoolMetrics, "conditional_tx"}, 1)
+
+	return nil
+}
+
+// TxConditions returns the conditions of the transaction with the given hash,
+// or nil if the transaction is not conditional
+func (p *TxPool) TxConditions(hash types.Hash) *types.TxConditions {
+	return p.conditionals.get(hash)
+}
+
+// RemoveConditionalTx removes the promoted conditional transaction whose conditions have failed
+// while building a block. Unlike Drop, only the transaction itself is removed: the transactions
+// of the same account with the higher nonces are moved back to the enqueued ones,
+// so they are kept until the removed nonce is filled again
+func (p *TxPool) RemoveConditionalTx(hash types.Hash) {
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return
+	}
+
+	account := p.accounts.get(tx.From())
+
+	account.promoted.lock(true)
+	account.enqueued.lock(true)
+	account.nonceToTx.lock()
+
+	defer func() {
+		account.nonceToTx.unlock()
+		account.enqueued.unlock()
+		account.promoted.unlock()
+	}()
+
+	// the transaction could have been removed or popped meanwhile
+	if first := account.promoted.peek(); first == nil || first.Hash() != hash {
+		return
+	}
+
+	account.promoted.pop()
+	account.nonceToTx.remove(tx)
+
+	requeued := account.promoted.clear()
+	for _, next := range requeued {
+		account.enqueued.push(next)
+	}
+
+	account.setNonce(tx.Nonce())
+
+	p.index.remove(tx)
+	p.gauge.decrease(slotsRequired(tx))
+	p.updatePending(-1 * int64(1+len(requeued)))
+	p.conditionals.remove(hash)
+
+	p.signalEvent(proto.EventType_DROPPED, reasonConditionsFailed, hash)
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("removed conditional tx", "hash", hash.String(), "requeued", len(requeued))
+	}
+}
+
+// checkConditions checks the conditions against the state of the given head,
+// for the earliest block which can be built on top of it
+func (p *TxPool) checkConditions(head *types.Header, conditions *types.TxConditions) error {
+	err := conditions.Check(head.Number+1, head.Timestamp+1, func(addr types.Address, slot types.Hash) types.Hash {
+		return p.store.GetStorage(head.StateRoot, addr, slot)
+	})
+
+	// the conditional transaction waits in the pool for its block range
+	if errors.Is(err, types.ErrTxConditionsPremature) {
+		return nil
+	}
+
+	return err
+}
+
+// pruneConditionals forgets the conditions of the transactions which left the pool,
+// and removes the transactions whose conditions no longer hold against the head state
+func (p *TxPool) pruneConditionals() {
+	head := p.store.Header()
+
+	for hash, conditions := range p.conditionals.all() {
+		if _, ok := p.index.get(hash); ok {
+			err := p.checkConditions(head, conditions)
+			if err == nil {
+				continue
+			}
+
+			if p.logger.IsDebug() {
+				p.logger.Debug("removing conditional tx", "hash", hash.String(), "err", err)
+			}
+
+			p.removeTx(hash, reasonConditionsFailed)
+		}
+
+		p.conditionals.remove(hash)
+	}
+}
@@ -0,0 +1,190 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestAddConditionalTx(t *testing.T) {
+	t.Parallel()
+
+	head := &types.Header{Number: 5, GasLimit: mockHeader.GasLimit}
+	contract := types.StringToAddress("0x1001")
+	slot, value := types.StringToHash("0x1"), types.StringToHash("0x2")
+
+	// newConditionalTestPool returns the pool on top of the state with the given contract storage
+	newConditionalTestPool := func(t *testing.T, storage map[types.Hash]types.Hash) *TxPool {
+		t.Helper()
+
+		store := NewDefaultMockStore(head)
+		store.getStorageFn = func(_ types.Hash, addr types.Address, slot types.Hash) types.Hash {
+			if addr != contract {
+				return types.ZeroHash
+			}
+
+			return storage[slot]
+		}
+
+		pool, err := newTestPoolWithSlots(10, store)
+		require.NoError(t, err)
+
+		pool.SetSigner(&mockSigner{})
+
+		t.Cleanup(pool.Close)
+
+		return pool
+	}
+
+	knownSlot := func(value types.Hash) map[types.Address]map[types.Hash]types.Hash {
+		return map[types.Address]map[types.Hash]types.Hash{contract: {slot: value}}
+	}
+
+	t.Run("invalid conditions", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newConditionalTestPool(t, map[types.Hash]types.Hash{slot: value})
+
+		tooManySlots := map[types.Hash]types.Hash{}
+		for i := 0; i <= maxConditionSlots; i++ {
+			tooManySlots[types.BytesToHash([]byte{byte(i), byte(i >> 8)})] = types.ZeroHash
+		}
+
+		cases := []struct {
+			name       string
+			conditions *types.TxConditions
+			err        error
+		}{
+			{
+				name:       "too many slots",
+				conditions: &types.TxConditions{KnownAccounts: map[types.Address]map[types.Hash]types.Hash{contract: tooManySlots}},
+				err:        ErrTooManyConditionSlots,
+			},
+			{
+				name:       "inverted block range",
+				conditions: &types.TxConditions{BlockNumberMin: 5, BlockNumberMax: 4},
+				err:        ErrInvalidTxConditions,
+			},
+			{
+				name:       "expired block range",
+				conditions: &types.TxConditions{BlockNumberMax: head.Number},
+				err:        types.ErrTxConditionsExpired,
+			},
+			{
+				name:       "storage mismatch",
+				conditions: &types.TxConditions{KnownAccounts: knownSlot(types.ZeroHash)},
+				err:        types.ErrTxConditionsStorage,
+			},
+		}
+
+		for _, c := range cases {
+			require.ErrorIs(t, pool.AddConditionalTx(newPricedTx(addr1, 0, 2), c.conditions), c.err, c.name)
+		}
+
+		require.Empty(t, pool.conditionals.all())
+		require.Equal(t, uint64(0), pool.gauge.read())
+	})
+
+	t.Run("premature conditions wait in the pool", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newConditionalTestPool(t, map[types.Hash]types.Hash{slot: value})
+		conditions := &types.TxConditions{KnownAccounts: knownSlot(value), BlockNumberMin: 10}
+		tx := newPricedTx(addr1, 0, 2)
+
+		require.NoError(t, pool.AddConditionalTx(tx, conditions))
+		require.Equal(t, conditions, pool.TxConditions(tx.Hash()))
+
+		// the conditional tx isn't journaled
+		pool.locals.add(addr2)
+		require.NoError(t, pool.addTx(local, newPricedTx(addr2, 0, 3)))
+		require.Len(t, pool.localTxs(), 1)
+	})
+
+	t.Run("prunes the txs whose conditions no longer hold", func(t *testing.T) {
+		t.Parallel()
+
+		otherSlot := types.StringToHash("0x3")
+		storage := map[types.Hash]types.Hash{slot: value, otherSlot: value}
+		pool := newConditionalTestPool(t, storage)
+
+		// the prices differ, so the hashes of the txs do
+		held, broken, removed := newPricedTx(addr1, 0, 2), newPricedTx(addr2, 0, 3), newPricedTx(addr3, 0, 4)
+		require.NoError(t, pool.AddConditionalTx(held, &types.TxConditions{KnownAccounts: knownSlot(value)}))
+		require.NoError(t, pool.AddConditionalTx(broken, &types.TxConditions{
+			KnownAccounts: map[types.Address]map[types.Hash]types.Hash{contract: {otherSlot: value}},
+		}))
+		require.NoError(t, pool.AddConditionalTx(removed, &types.TxConditions{}))
+
+		// the third tx leaves the pool and the slot expected by the second tx changes
+		require.Equal(t, 1, pool.RemoveTx(removed.Hash()))
+
+		storage[otherSlot] = types.ZeroHash
+
+		pool.pruneConditionals()
+
+		require.Len(t, pool.conditionals.all(), 1)
+		require.NotNil(t, pool.TxConditions(held.Hash()))
+
+		_, ok := pool.index.get(held.Hash())
+		require.True(t, ok)
+
+		_, ok = pool.index.get(broken.Hash())
+		require.False(t, ok)
+
+		lifecycle, ok := pool.TxLifecycle(broken.Hash())
+		require.True(t, ok)
+
+		reasons := make([]string, len(lifecycle.Events))
+		for i, event := range lifecycle.Events {
+			reasons[i] = event.Reason
+		}
+
+		require.Contains(t, reasons, reasonConditionsFailed)
+	})
+
+	t.Run("removes only the conditional tx failed in the block", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newConditionalTestPool(t, map[types.Hash]types.Hash{slot: value})
+
+		failed, valid := newPricedTx(addr1, 0, 2), newPricedTx(addr1, 1, 2)
+
+		require.NoError(t, pool.AddConditionalTx(failed, &types.TxConditions{KnownAccounts: knownSlot(value)}))
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		require.NoError(t, pool.addTx(local, valid))
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		require.Equal(t, uint64(2), pool.accounts.get(addr1).promoted.length())
+
+		pool.RemoveConditionalTx(failed.Hash())
+
+		_, ok := pool.index.get(failed.Hash())
+		require.False(t, ok)
+		require.Nil(t, pool.TxConditions(failed.Hash()))
+
+		// the valid tx waits in the pool for the removed nonce
+		_, ok = pool.index.get(valid.Hash())
+		require.True(t, ok)
+
+		account := pool.accounts.get(addr1)
+		require.Equal(t, uint64(0), account.promoted.length())
+		require.Equal(t, uint64(1), account.enqueued.length())
+		require.Equal(t, uint64(0), account.getNonce())
+		require.Equal(t, uint64(1), pool.gauge.read())
+
+		lifecycle, ok := pool.TxLifecycle(failed.Hash())
+		require.True(t, ok)
+		require.Equal(t, reasonConditionsFailed, lifecycle.Events[len(lifecycle.Events)-1].Reason)
+
+		// filling the nonce promotes the valid tx again
+		require.NoError(t, pool.addTx(local, newPricedTx(addr1, 0, 3)))
+		pool.handlePromoteRequest(<-pool.promoteReqCh)
+
+		require.Equal(t, uint64(2), account.promoted.length())
+		require.Equal(t, uint64(2), account.getNonce())
+	})
+}
@@ -0,0 +1,202 @@
+package txpool
+
+import (
+	"container/heap"
+	"math/big"
+
+	"github.com/armon/go-metrics"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// makeRoom evicts the cheaper remote transactions when the pool has no room for the given transaction.
+// Under high pressure a future transaction (not executable by its nonce) is accepted only
+// by replacing cheaper future transactions, so that it never pushes the executable ones out.
+// The future transactions of the priority accounts are not subject to that restriction.
+// It must be called before the account of the transaction is locked,
+// since the evicted transactions belong to the other accounts
+func (p *TxPool) makeRoom(account *account, tx *types.Transaction) error {
+	account.nonceToTx.lock()
+	replacement := account.nonceToTx.get(tx.Nonce()) != nil
+	account.nonceToTx.unlock()
+
+	accountNonce := account.getNonce()
+	isFuture := tx.Nonce() > accountNonce
+	slots := slotsRequired(tx)
+
+	if p.gauge.highPressure() {
+		p.signalPruning()
+
+		if isFuture && !p.priority.contains(tx.From()) {
+			if replacement || !p.evictUnderpriced(tx, slots, true) {
+				metrics.IncrCounter([]string{txPoolMetrics, "rejected_future_tx"}, 1)
+
+				return ErrRejectFutureTx
+			}
+
+			return nil
+		}
+	}
+
+	// a replacement frees the slots of the replaced transaction, and a transaction with a low nonce
+	// or a known one is going to be rejected anyway, so nothing is evicted for them
+	if replacement || tx.Nonce() < accountNonce {
+		return nil
+	}
+
+	if _, known := p.index.get(tx.Hash()); known {
+		return nil
+	}
+
+	if free := p.gauge.freeSlots(); free < slots && !p.evictUnderpriced(tx, slots-free, isFuture) {
+		return ErrTxPoolOverflow
+	}
+
+	return nil
+}
+
+// evictUnderpriced evicts the cheapest remote transactions which pay less than the given transaction,
+// until the given number of slots is freed. The transactions of the local and priority accounts
+// and of the sender are never evicted, and the promoted ones are not considered if only the enqueued are requested.
+// Nothing is evicted if enough slots can not be freed. Returns true if the slots have been freed
+func (p *TxPool) evictUnderpriced(tx *types.Transaction, slots uint64, enqueuedOnly bool) bool {
+	baseFee := new(big.Int).SetUint64(p.GetBaseFee())
+	candidates := &evictionQueue{baseFee: baseFee}
+
+	p.accounts.Range(func(key, value interface{}) bool {
+		addr, _ := key.(types.Address)
+		account, _ := value.(*account)
+
+		if addr == tx.From() || p.locals.contains(addr) || p.priority.contains(addr) {
+			return true
+		}
+
+		if txs := account.evictable(enqueuedOnly); len(txs) > 0 {
+			candidates.cursors = append(candidates.cursors, &evictionCursor{account: account, txs: txs})
+		}
+
+		return true
+	})
+
+	heap.Init(candidates)
+
+	// select the transactions before evicting any, so nothing is evicted in vain
+	var (
+		selected []*types.Transaction
+		freed    uint64
+	)
+
+	for freed < slots {
+		if candidates.Len() == 0 {
+			return false
+		}
+
+		cursor := candidates.cursors[0]
+		cheapest := cursor.txs[0]
+
+		if cmp(tx, cheapest, baseFee) <= 0 {
+			return false
+		}
+
+		selected = append(selected, cheapest)
+		freed += slotsRequired(cheapest)
+
+		if cursor.txs = cursor.txs[1:]; len(cursor.txs) == 0 {
+			heap.Pop(candidates)
+		} else {
+			heap.Fix(candidates, 0)
+		}
+	}
+
+	for _, evictedTx := range selected {
+		// the account could have changed in the meantime
+		if !p.evictTx(evictedTx, reasonUnderpriced) {
+			continue
+		}
+
+		metrics.IncrCounter([]string{txPoolMetrics, "evicted_tx"}, 1)
+
+		if p.logger.IsDebug() {
+			p.logger.Debug("evicted underpriced tx",
+				"hash", evictedTx.Hash().String(),
+				"replaced_by", tx.Hash().String(),
+			)
+		}
+	}
+
+	return true
+}
+
+// evictTx removes the transaction from the pool if it has the highest nonce of its account.
+// Returns true if the transaction has been removed
+func (p *TxPool) evictTx(tx *types.Transaction, reason string) bool {
+	account := p.accounts.get(tx.From())
+
+	evicted, promoted := account.evict(tx)
+	if !evicted {
+		return false
+	}
+
+	p.index.remove(tx)
+	p.gauge.decrease(slotsRequired(tx))
+
+	if promoted {
+		p.updatePending(-1)
+	}
+
+	p.signalEvent(proto.EventType_DROPPED, reason, tx.Hash())
+
+	return true
+}
+
+// evictionCursor points to the next transaction which can be evicted from an account
+type evictionCursor struct {
+	account *account
+	txs     []*types.Transaction
+}
+
+// accounts sorted by the gas price of their next evictable transaction (ascending)
+type evictionQueue struct {
+	baseFee *big.Int
+	cursors []*evictionCursor
+}
+
+/* Queue methods required by the heap interface */
+
+func (q *evictionQueue) Len() int {
+	return len(q.cursors)
+}
+
+func (q *evictionQueue) Swap(i, j int) {
+	q.cursors[i], q.cursors[j] = q.cursors[j], q.cursors[i]
+}
+
+func (q *evictionQueue) Less(i, j int) bool {
+	a, b := q.cursors[i].txs[0], q.cursors[j].txs[0]
+
+	if c := cmp(a, b, q.baseFee); c != 0 {
+		return c < 0
+	}
+
+	// the newer transactions are evicted first
+	return a.Nonce() > b.Nonce()
+}
+
+func (q *evictionQueue) Push(x interface{}) {
+	cursor, ok := x.(*evictionCursor)
+	if !ok {
+		return
+	}
+
+	q.cursors = append(q.cursors, cursor)
+}
+
+func (q *evictionQueue) Pop() interface{} {
+	old := q.cursors
+	n := len(old)
+	x := old[n-1]
+	q.cursors = old[0 : n-1]
+
+	return x
+}
@@ -0,0 +1,135 @@
+package txpool
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// newPricedTx returns a new valid single slot legacy tx with the given gas price
+func newPricedTx(addr types.Address, nonce uint64, gasPrice int64) *types.Transaction {
+	tx := newTx(addr, nonce, 1, types.LegacyTxType)
+	tx.SetGasPrice(big.NewInt(gasPrice))
+
+	return tx
+}
+
+func newEvictionTestPool(t *testing.T, maxSlots uint64) *TxPool {
+	t.Helper()
+
+	pool, err := newTestPoolWithSlots(maxSlots)
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func TestEvictUnderpriced(t *testing.T) {
+	t.Parallel()
+
+	t.Run("evicts the cheapest remote tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 3)
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, 0, 5)))
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 2)))
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr3, 0, 8)))
+
+		tx := newPricedTx(addr4, 0, 3)
+		require.NoError(t, pool.addTx(gossip, tx))
+
+		require.Equal(t, uint64(3), pool.gauge.read())
+		require.Equal(t, uint64(0), pool.accounts.get(addr2).enqueued.length())
+		require.Equal(t, uint64(1), pool.accounts.get(addr4).enqueued.length())
+		require.Len(t, pool.index.all, 3)
+
+		_, ok := pool.index.get(tx.Hash())
+		require.True(t, ok)
+	})
+
+	t.Run("rejects a tx which does not pay more", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 2)
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, 0, 5)))
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 5)))
+
+		require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr3, 0, 5)), ErrTxPoolOverflow)
+		require.Len(t, pool.index.all, 2)
+	})
+
+	t.Run("never evicts local txs", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 2)
+
+		require.NoError(t, pool.addTx(local, newPricedTx(addr1, 0, 1)))
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 2)))
+
+		// only the remote tx can be evicted, which is not enough room for two txs
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr3, 0, 10)))
+		require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr4, 0, 10)), ErrTxPoolOverflow)
+
+		require.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+		require.Equal(t, uint64(0), pool.accounts.get(addr2).enqueued.length())
+	})
+
+	t.Run("evicts from the highest nonce", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 3)
+
+		for nonce := uint64(0); nonce < 3; nonce++ {
+			require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, nonce, 2)))
+		}
+
+		pool.handlePromoteRequest(promoteRequest{account: addr1})
+
+		acc := pool.accounts.get(addr1)
+		require.Equal(t, uint64(3), acc.promoted.length())
+		require.Equal(t, uint64(3), acc.getNonce())
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 3)))
+
+		// the account nonce is rolled back to the evicted tx
+		require.Equal(t, uint64(2), acc.promoted.length())
+		require.Equal(t, uint64(2), acc.getNonce())
+		require.Nil(t, acc.nonceToTx.get(2))
+		require.NotNil(t, acc.nonceToTx.get(1))
+	})
+
+	t.Run("future tx replaces only future txs under high pressure", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 5)
+
+		// executable txs
+		for nonce := uint64(0); nonce < 4; nonce++ {
+			require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, nonce, 1)))
+		}
+
+		pool.handlePromoteRequest(promoteRequest{account: addr1})
+
+		// a future tx, which makes the pool high pressured
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 5, 2)))
+		require.True(t, pool.gauge.highPressure())
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr3, 5, 10)))
+		require.Equal(t, uint64(0), pool.accounts.get(addr2).enqueued.length())
+		require.Equal(t, uint64(1), pool.accounts.get(addr3).enqueued.length())
+
+		// the cheaper executable txs are not pushed out by a future tx
+		require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr4, 5, 5)), ErrRejectFutureTx)
+
+		require.Equal(t, uint64(4), pool.accounts.get(addr1).promoted.length())
+		require.Equal(t, uint64(5), pool.gauge.read())
+	})
+}
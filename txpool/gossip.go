@@ -0,0 +1,402 @@
+package txpool
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/network/grpc"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// topicNameV2 is the topic announcing only the hashes of the new transactions
+	topicNameV2 = "txpool/0.2"
+
+	// txpoolProto is the protocol serving the announced transactions to the peers
+	txpoolProto = "/txpool/0.2"
+
+	// maxAnnouncedHashes is the maximum number of hashes in an announcement or a fetch request
+	maxAnnouncedHashes = 256
+
+	// txFetchTimeout is the timeout of a single fetch request
+	txFetchTimeout = 5 * time.Second
+)
+
+var errTooManyHashes = fmt.Errorf("too many transaction hashes, the maximum is %d", maxAnnouncedHashes)
+
+// txGossipPeers is the peers side of the announce and fetch gossip
+type txGossipPeers interface {
+	// fetchTxs requests the transactions with the given hashes from the peer
+	fetchTxs(peerID peer.ID, hashes []types.Hash) ([]*types.Transaction, error)
+	// hasLegacyPeers returns true if some of the connected peers don't support the announcements,
+	// so the full transactions have to be broadcast as well
+	hasLegacyPeers() bool
+	// penalize disconnects the misbehaving peer
+	penalize(peerID peer.ID, reason string)
+}
+
+// setupGossip subscribes to both gossip topics and starts serving the announced transactions.
+// The full transactions topic (txpool/0.1) is kept for the networks with nodes
+// which don't support the announcements yet
+func (p *TxPool) setupGossip(server *network.Server) error {
+	topic, err := server.NewTopic(topicNameV1, &proto.Txn{})
+	if err != nil {
+		return err
+	}
+
+	if err := topic.Subscribe(p.addGossipTx); err != nil {
+		return fmt.Errorf("unable to subscribe to gossip topic, %w", err)
+	}
+
+	announceTopic, err := server.NewTopic(topicNameV2, &proto.TxnHashes{})
+	if err != nil {
+		return err
+	}
+
+	if err := announceTopic.SubscribeDelivered(p.handleTxAnnouncement); err != nil {
+		return fmt.Errorf("unable to subscribe to announcements topic, %w", err)
+	}
+
+	p.gossipStream = grpc.NewGrpcStream()
+
+	proto.RegisterTxnPoolGossipServer(p.gossipStream.GrpcServer(), &gossipService{pool: p})
+	p.gossipStream.Serve()
+	server.RegisterProtocol(txpoolProto, p.gossipStream)
+
+	p.topic = topic
+	p.announceTopic = announceTopic
+	p.gossipPeers = &networkGossipPeers{network: server}
+
+	return nil
+}
+
+// broadcastTxs announces the hashes of the transactions to the network,
+// and publishes the full transactions if some of the direct peers can't fetch them.
+// The fetched transactions are broadcast again, so every hop serves them to its own peers
+func (p *TxPool) broadcastTxs(txs ...*types.Transaction) {
+	if len(txs) == 0 {
+		return
+	}
+
+	if p.announceTopic != nil {
+		announcement := &proto.TxnHashes{
+			Hashes: make([][]byte, len(txs)),
+		}
+
+		for i, tx := range txs {
+			announcement.Hashes[i] = tx.Hash().Bytes()
+		}
+
+		if err := p.announceTopic.Publish(announcement); err != nil {
+			p.logger.Error("failed to announce txs", "err", err)
+		}
+	}
+
+	if p.topic == nil || (p.gossipPeers != nil && !p.gossipPeers.hasLegacyPeers()) {
+		return
+	}
+
+	for _, tx := range txs {
+		raw := &proto.Txn{
+			Raw: &any.Any{
+				Value: tx.MarshalRLP(),
+			},
+		}
+
+		if err := p.topic.Publish(raw); err != nil {
+			p.logger.Error("failed to topic tx", "err", err)
+		}
+	}
+}
+
+// handleTxAnnouncement handles the transaction hashes announced by the network,
+// the transactions which are not known by the pool are fetched from the peer which has
+// delivered the announcement, and the accepted ones are announced to the next hop
+func (p *TxPool) handleTxAnnouncement(obj interface{}, peerID peer.ID) {
+	if !p.sealing.Load() || p.localPeerID == peerID {
+		return
+	}
+
+	announcement, ok := obj.(*proto.TxnHashes)
+	if !ok {
+		p.logger.Error("failed to cast announcement message to txn hashes")
+
+		return
+	}
+
+	if len(announcement.Hashes) == 0 || len(announcement.Hashes) > maxAnnouncedHashes {
+		p.logger.Error("malformed transactions announcement received", "hashes", len(announcement.Hashes))
+
+		return
+	}
+
+	unknown := p.unknownHashes(announcement.Hashes)
+	if len(unknown) == 0 {
+		return
+	}
+
+	// the announcements of the known transactions only don't spend the peer tokens
+	if err := p.allowPeer(peerID); err != nil {
+		if p.logger.IsDebug() {
+			p.logger.Debug("rejecting txs announcement", "err", err, "peer", peerID)
+		}
+
+		return
+	}
+
+	hashes := p.fetching.acquire(peerID, unknown)
+	if len(hashes) == 0 {
+		return
+	}
+
+	p.fetchAnnouncedTxs(peerID, hashes)
+}
+
+// fetchAnnouncedTxs fetches the transactions from the peer and announces the accepted ones.
+// The transactions the peer hasn't returned are fetched from the other peers which have
+// announced them meanwhile, since a relaying peer may announce a transaction before having it
+func (p *TxPool) fetchAnnouncedTxs(peerID peer.ID, hashes []types.Hash) {
+	requested := make(map[types.Hash]bool, len(hashes))
+	for _, hash := range hashes {
+		requested[hash] = false
+	}
+
+	txs, err := p.gossipPeers.fetchTxs(peerID, hashes)
+	if err != nil {
+		p.logger.Debug("failed to fetch announced txs", "peer", peerID, "err", err)
+	}
+
+	added := make([]*types.Transaction, 0, len(txs))
+
+	for _, tx := range txs {
+		hash := tx.ComputeHash().Hash()
+		if _, ok := requested[hash]; !ok {
+			metrics.IncrCounter([]string{txPoolMetrics, "unrequested_fetched_tx"}, 1)
+
+			continue
+		}
+
+		requested[hash] = true
+
+		if p.addRemoteTx(tx) {
+			added = append(added, tx)
+		}
+	}
+
+	p.broadcastTxs(added...)
+
+	fetched := make([]types.Hash, 0, len(hashes))
+	missing := make([]types.Hash, 0, len(hashes))
+
+	for _, hash := range hashes {
+		if requested[hash] {
+			fetched = append(fetched, hash)
+		} else {
+			missing = append(missing, hash)
+		}
+	}
+
+	p.fetching.release(fetched)
+
+	for alternate, alternateHashes := range p.fetching.reassign(missing) {
+		p.fetchAnnouncedTxs(alternate, alternateHashes)
+	}
+}
+
+// unknownHashes returns the well formed hashes of the transactions missing from the pool
+func (p *TxPool) unknownHashes(rawHashes [][]byte) []types.Hash {
+	hashes := make([]types.Hash, 0, len(rawHashes))
+
+	for _, raw := range rawHashes {
+		if len(raw) != types.HashLength {
+			continue
+		}
+
+		hash := types.BytesToHash(raw)
+		if _, known := p.index.get(hash); !known {
+			hashes = append(hashes, hash)
+		}
+	}
+
+	return hashes
+}
+
+// fetchingSet keeps the hashes of the transactions being fetched,
+// so the same transaction announced by several peers is fetched only once.
+// The other announcers of a hash are kept as the alternates to fetch it from
+type fetchingSet struct {
+	sync.Mutex
+
+	hashes map[types.Hash][]peer.ID
+}
+
+func newFetchingSet() *fetchingSet {
+	return &fetchingSet{hashes: make(map[types.Hash][]peer.ID)}
+}
+
+// acquire marks the given hashes announced by the peer as being fetched,
+// and returns the ones which haven't been already
+func (s *fetchingSet) acquire(peerID peer.ID, hashes []types.Hash) []types.Hash {
+	s.Lock()
+	defer s.Unlock()
+
+	acquired := make([]types.Hash, 0, len(hashes))
+
+	for _, hash := range hashes {
+		if alternates, ok := s.hashes[hash]; ok {
+			s.hashes[hash] = append(alternates, peerID)
+
+			continue
+		}
+
+		s.hashes[hash] = []peer.ID{}
+		acquired = append(acquired, hash)
+	}
+
+	return acquired
+}
+
+// reassign groups the given hashes by the next alternate announcer to fetch them from,
+// the hashes without any alternate are released
+func (s *fetchingSet) reassign(hashes []types.Hash) map[peer.ID][]types.Hash {
+	s.Lock()
+	defer s.Unlock()
+
+	reassigned := make(map[peer.ID][]types.Hash)
+
+	for _, hash := range hashes {
+		alternates := s.hashes[hash]
+		if len(alternates) == 0 {
+			delete(s.hashes, hash)
+
+			continue
+		}
+
+		s.hashes[hash] = alternates[1:]
+		reassigned[alternates[0]] = append(reassigned[alternates[0]], hash)
+	}
+
+	return reassigned
+}
+
+// release unmarks the given hashes
+func (s *fetchingSet) release(hashes []types.Hash) {
+	s.Lock()
+	defer s.Unlock()
+
+	for _, hash := range hashes {
+		delete(s.hashes, hash)
+	}
+}
+
+// gossipService serves the transactions of the pool to the peers
+type gossipService struct {
+	proto.UnimplementedTxnPoolGossipServer
+
+	pool *TxPool
+}
+
+// GetTxns is a gRPC endpoint to return the transactions by their hashes,
+// the ones missing from the pool are skipped
+func (s *gossipService) GetTxns(ctx context.Context, req *proto.TxnHashes) (*proto.TxnBodies, error) {
+	if len(req.Hashes) > maxAnnouncedHashes {
+		return nil, errTooManyHashes
+	}
+
+	resp := &proto.TxnBodies{
+		Raw: make([][]byte, 0, len(req.Hashes)),
+	}
+
+	for _, raw := range req.Hashes {
+		if tx, ok := s.pool.index.get(types.BytesToHash(raw)); ok {
+			resp.Raw = append(resp.Raw, tx.MarshalRLP())
+		}
+	}
+
+	return resp, nil
+}
+
+// networkGossipPeers fetches the transactions from the peers over the libp2p streams
+type networkGossipPeers struct {
+	network *network.Server
+}
+
+func (n *networkGossipPeers) fetchTxs(peerID peer.ID, hashes []types.Hash) ([]*types.Transaction, error) {
+	conn, err := n.network.NewProtoConnection(txpoolProto, peerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open a stream, err %w", err)
+	}
+
+	defer conn.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), txFetchTimeout)
+	defer cancel()
+
+	req := &proto.TxnHashes{
+		Hashes: make([][]byte, len(hashes)),
+	}
+
+	for i, hash := range hashes {
+		req.Hashes[i] = hash.Bytes()
+	}
+
+	resp, err := proto.NewTxnPoolGossipClient(conn).GetTxns(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(resp.Raw) > len(hashes) {
+		return nil, errors.New("more transactions returned than requested")
+	}
+
+	txs := make([]*types.Transaction, len(resp.Raw))
+
+	for i, raw := range resp.Raw {
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, fmt.Errorf("failed to decode fetched tx, err %w", err)
+		}
+
+		txs[i] = tx
+	}
+
+	return txs, nil
+}
+
+func (n *networkGossipPeers) penalize(peerID peer.ID, reason string) {
+	n.network.DisconnectFromPeer(peerID, reason)
+}
+
+func (n *networkGossipPeers) hasLegacyPeers() bool {
+	for _, peerInfo := range n.network.Peers() {
+		protocols, err := n.network.GetProtocols(peerInfo.Info.ID)
+		if err != nil {
+			return true
+		}
+
+		supported := false
+
+		for _, protocol := range protocols {
+			if protocol == txpoolProto {
+				supported = true
+
+				break
+			}
+		}
+
+		if !supported {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,295 @@
+package txpool
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/network"
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+// mockGossipPeers serves the fetch requests from the given pool,
+// except the ones sent to the missingFrom peer which returns nothing
+type mockGossipPeers struct {
+	source      *TxPool
+	extra       []*types.Transaction
+	missingFrom peer.ID
+	requested   [][]types.Hash
+	from        []peer.ID
+	penalized   []peer.ID
+}
+
+func (m *mockGossipPeers) fetchTxs(peerID peer.ID, hashes []types.Hash) ([]*types.Transaction, error) {
+	m.requested = append(m.requested, hashes)
+	m.from = append(m.from, peerID)
+
+	if peerID == m.missingFrom {
+		return nil, nil
+	}
+
+	req := &proto.TxnHashes{}
+	for _, hash := range hashes {
+		req.Hashes = append(req.Hashes, hash.Bytes())
+	}
+
+	resp, err := (&gossipService{pool: m.source}).GetTxns(context.Background(), req)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*types.Transaction, 0, len(resp.Raw))
+
+	for _, raw := range resp.Raw {
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return nil, err
+		}
+
+		txs = append(txs, tx)
+	}
+
+	return append(txs, m.extra...), nil
+}
+
+func (m *mockGossipPeers) hasLegacyPeers() bool {
+	return false
+}
+
+func (m *mockGossipPeers) penalize(peerID peer.ID, _ string) {
+	m.penalized = append(m.penalized, peerID)
+}
+
+func newGossipTestPool(t *testing.T) *TxPool {
+	t.Helper()
+
+	pool, err := newTestPool()
+	require.NoError(t, err)
+
+	pool.SetSigner(&mockSigner{})
+	pool.SetSealing(true)
+
+	t.Cleanup(pool.Close)
+
+	return pool
+}
+
+func TestGossipService_GetTxns(t *testing.T) {
+	t.Parallel()
+
+	pool := newGossipTestPool(t)
+
+	known := newTx(addr1, 0, 1, types.LegacyTxType)
+	require.NoError(t, pool.addTx(gossip, known))
+
+	unknown := newTx(addr2, 0, 1, types.LegacyTxType).ComputeHash()
+
+	service := &gossipService{pool: pool}
+
+	resp, err := service.GetTxns(context.Background(), &proto.TxnHashes{
+		Hashes: [][]byte{known.Hash().Bytes(), unknown.Hash().Bytes()},
+	})
+	require.NoError(t, err)
+	require.Equal(t, [][]byte{known.MarshalRLP()}, resp.Raw)
+
+	_, err = service.GetTxns(context.Background(), &proto.TxnHashes{
+		Hashes: make([][]byte, maxAnnouncedHashes+1),
+	})
+	require.ErrorIs(t, err, errTooManyHashes)
+}
+
+func TestHandleTxAnnouncement(t *testing.T) {
+	t.Parallel()
+
+	announce := func(txs ...*types.Transaction) *proto.TxnHashes {
+		announcement := &proto.TxnHashes{}
+		for _, tx := range txs {
+			announcement.Hashes = append(announcement.Hashes, tx.Hash().Bytes())
+		}
+
+		return announcement
+	}
+
+	t.Run("fetches only the unknown txs", func(t *testing.T) {
+		t.Parallel()
+
+		source := newGossipTestPool(t)
+		pool := newGossipTestPool(t)
+
+		peers := &mockGossipPeers{source: source}
+		pool.gossipPeers = peers
+
+		known := newTx(addr1, 0, 1, types.LegacyTxType)
+		missing := newTx(addr2, 0, 1, types.LegacyTxType)
+
+		require.NoError(t, source.addTx(gossip, known))
+		require.NoError(t, source.addTx(gossip, missing))
+		require.NoError(t, pool.addTx(gossip, known))
+
+		pool.handleTxAnnouncement(announce(known, missing), peer.ID("peer"))
+
+		require.Equal(t, [][]types.Hash{{missing.Hash()}}, peers.requested)
+
+		_, ok := pool.index.get(missing.Hash())
+		require.True(t, ok)
+		require.Empty(t, pool.fetching.hashes)
+	})
+
+	t.Run("ignores the unrequested txs", func(t *testing.T) {
+		t.Parallel()
+
+		source := newGossipTestPool(t)
+		pool := newGossipTestPool(t)
+
+		announced := newTx(addr1, 0, 1, types.LegacyTxType)
+		unrequested := newTx(addr2, 0, 1, types.LegacyTxType).ComputeHash()
+
+		require.NoError(t, source.addTx(gossip, announced))
+
+		pool.gossipPeers = &mockGossipPeers{source: source, extra: []*types.Transaction{unrequested}}
+
+		pool.handleTxAnnouncement(announce(announced), peer.ID("peer"))
+
+		_, ok := pool.index.get(announced.Hash())
+		require.True(t, ok)
+
+		_, ok = pool.index.get(unrequested.Hash())
+		require.False(t, ok)
+	})
+
+	t.Run("ignored by a non sealing node", func(t *testing.T) {
+		t.Parallel()
+
+		source := newGossipTestPool(t)
+		pool := newGossipTestPool(t)
+		pool.SetSealing(false)
+
+		peers := &mockGossipPeers{source: source}
+		pool.gossipPeers = peers
+
+		tx := newTx(addr1, 0, 1, types.LegacyTxType)
+		require.NoError(t, source.addTx(gossip, tx))
+
+		pool.handleTxAnnouncement(announce(tx), peer.ID("peer"))
+
+		require.Empty(t, peers.requested)
+		require.Equal(t, uint64(0), pool.Length())
+	})
+
+	t.Run("skips the txs being fetched", func(t *testing.T) {
+		t.Parallel()
+
+		source := newGossipTestPool(t)
+		pool := newGossipTestPool(t)
+
+		peers := &mockGossipPeers{source: source}
+		pool.gossipPeers = peers
+
+		tx := newTx(addr1, 0, 1, types.LegacyTxType)
+		require.NoError(t, source.addTx(gossip, tx))
+
+		pool.fetching.acquire(peer.ID("other"), []types.Hash{tx.Hash()})
+
+		pool.handleTxAnnouncement(announce(tx), peer.ID("peer"))
+
+		require.Empty(t, peers.requested)
+		require.Equal(t, []peer.ID{"peer"}, pool.fetching.hashes[tx.Hash()])
+	})
+
+	t.Run("fetches the missing txs from the other announcers", func(t *testing.T) {
+		t.Parallel()
+
+		source := newGossipTestPool(t)
+		pool := newGossipTestPool(t)
+
+		tx := newTx(addr1, 0, 1, types.LegacyTxType)
+		require.NoError(t, source.addTx(gossip, tx))
+
+		// the first announcer doesn't have the tx yet, while the second one has announced it meanwhile
+		peers := &mockGossipPeers{source: source, missingFrom: peer.ID("first")}
+		pool.gossipPeers = peers
+
+		hashes := pool.fetching.acquire(peer.ID("first"), []types.Hash{tx.Hash()})
+		pool.fetching.acquire(peer.ID("second"), []types.Hash{tx.Hash()})
+
+		pool.fetchAnnouncedTxs(peer.ID("first"), hashes)
+
+		require.Equal(t, []peer.ID{"first", "second"}, peers.from)
+
+		_, ok := pool.index.get(tx.Hash())
+		require.True(t, ok)
+		require.Empty(t, pool.fetching.hashes)
+	})
+}
+
+func TestHandleTxAnnouncement_MultiHop(t *testing.T) {
+	t.Parallel()
+
+	newNetworkPool := func(t *testing.T) (*TxPool, *network.Server) {
+		t.Helper()
+
+		server, err := network.CreateServer(&network.CreateServerParams{
+			ConfigCallback: func(c *network.Config) {
+				c.NoDiscover = true
+			},
+		})
+		require.NoError(t, err)
+
+		t.Cleanup(func() {
+			require.NoError(t, server.Close())
+		})
+
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			getDefaultEnabledForks(),
+			defaultMockStore{DefaultHeader: mockHeader},
+			nil,
+			server,
+			&Config{
+				PriceLimit:         defaultPriceLimit,
+				MaxSlots:           defaultMaxSlots,
+				MaxAccountEnqueued: defaultMaxAccountEnqueued,
+				PeerID:             server.AddrInfo().ID,
+			},
+		)
+		require.NoError(t, err)
+
+		pool.SetSigner(&mockSigner{})
+		pool.SetSealing(true)
+
+		// only the announcements are gossiped, so the txs can reach the next hop by fetching only
+		pool.topic = nil
+
+		t.Cleanup(pool.Close)
+
+		return pool, server
+	}
+
+	// the pools are connected in a line, so the first and the last ones are not direct peers
+	first, firstServer := newNetworkPool(t)
+	middle, middleServer := newNetworkPool(t)
+	last, lastServer := newNetworkPool(t)
+
+	require.NoError(t, network.JoinAndWait(firstServer, middleServer, network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+	require.NoError(t, network.JoinAndWait(middleServer, lastServer, network.DefaultBufferTimeout, network.DefaultJoinTimeout))
+
+	// wait for the gossip mesh to be formed
+	time.Sleep(2 * time.Second)
+
+	tx := newTx(addr1, 0, 1, types.LegacyTxType)
+	require.NoError(t, first.AddTx(tx))
+
+	require.Eventually(t, func() bool {
+		_, ok := last.index.get(tx.Hash())
+
+		return ok
+	}, 10*time.Second, 100*time.Millisecond)
+
+	_, ok := middle.index.get(tx.Hash())
+	require.True(t, ok)
+}
@@ -0,0 +1,158 @@
+package txpool
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+var (
+	errJournalClosed = errors.New("journal is closed")
+)
+
+// txJournal is an append-only file of the locally submitted transactions,
+// which are replayed into the pool when the node restarts.
+// Each transaction is stored as its RLP encoding prefixed by the encoding length
+type txJournal struct {
+	path   string
+	writer *os.File
+}
+
+func newTxJournal(path string) *txJournal {
+	return &txJournal{path: path}
+}
+
+// load reads the transactions from the journal and passes them to the add function.
+// A truncated transaction at the end of the journal (e.g. after a crash mid-write) is ignored.
+// It returns the number of the loaded transactions and the number of the ones which have been rejected
+func (j *txJournal) load(add func(tx *types.Transaction) error) (int, int, error) {
+	file, err := os.Open(j.path)
+	if errors.Is(err, os.ErrNotExist) {
+		return 0, 0, nil
+	}
+
+	if err != nil {
+		return 0, 0, err
+	}
+	defer file.Close()
+
+	var (
+		reader   = bufio.NewReader(file)
+		loaded   = 0
+		rejected = 0
+	)
+
+	for {
+		size, err := binary.ReadUvarint(reader)
+		if err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return loaded, rejected, nil
+			}
+
+			return loaded, rejected, err
+		}
+
+		if size > txMaxSize {
+			return loaded, rejected, fmt.Errorf("journal entry of %d bytes exceeds the transaction size limit", size)
+		}
+
+		raw := make([]byte, size)
+		if _, err := io.ReadFull(reader, raw); err != nil {
+			if errors.Is(err, io.EOF) || errors.Is(err, io.ErrUnexpectedEOF) {
+				return loaded, rejected, nil
+			}
+
+			return loaded, rejected, err
+		}
+
+		tx := &types.Transaction{}
+		if err := tx.UnmarshalRLP(raw); err != nil {
+			return loaded, rejected, fmt.Errorf("failed to decode journaled transaction: %w", err)
+		}
+
+		loaded++
+
+		if err := add(tx); err != nil {
+			rejected++
+		}
+	}
+}
+
+// insert appends the transaction to the journal
+func (j *txJournal) insert(tx *types.Transaction) error {
+	if j.writer == nil {
+		return errJournalClosed
+	}
+
+	_, err := j.writer.Write(encodeJournalEntry(nil, tx))
+
+	return err
+}
+
+// rotate replaces the journal with one which holds only the given transactions,
+// so that the transactions which have left the pool are dropped from it
+func (j *txJournal) rotate(txs []*types.Transaction) error {
+	if j.writer != nil {
+		if err := j.writer.Close(); err != nil {
+			return err
+		}
+
+		j.writer = nil
+	}
+
+	if err := os.MkdirAll(filepath.Dir(j.path), 0755); err != nil {
+		return err
+	}
+
+	var buf []byte
+	for _, tx := range txs {
+		buf = encodeJournalEntry(buf, tx)
+	}
+
+	// write the new journal aside, so a crash does not lose the current one
+	tmpPath := j.path + ".new"
+
+	if err := os.WriteFile(tmpPath, buf, 0600); err != nil {
+		return err
+	}
+
+	if err := os.Rename(tmpPath, j.path); err != nil {
+		return err
+	}
+
+	writer, err := os.OpenFile(j.path, os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		return err
+	}
+
+	j.writer = writer
+
+	return nil
+}
+
+// close closes the journal file
+func (j *txJournal) close() error {
+	if j.writer == nil {
+		return nil
+	}
+
+	err := j.writer.Close()
+	j.writer = nil
+
+	return err
+}
+
+// encodeJournalEntry appends the length prefixed RLP encoding of the transaction to dst
+func encodeJournalEntry(dst []byte, tx *types.Transaction) []byte {
+	raw := tx.MarshalRLP()
+
+	dst = binary.AppendUvarint(dst, uint64(len(raw)))
+
+	return append(dst, raw...)
+}
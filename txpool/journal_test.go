@@ -0,0 +1,124 @@
+package txpool
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-hclog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestTxJournal(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "txpool", "transactions.journal")
+	journal := newTxJournal(path)
+
+	// inserting requires the journal to be opened by a rotation
+	require.ErrorIs(t, journal.insert(newTx(addr1, 0, 1, types.LegacyTxType)), errJournalClosed)
+
+	txs := []*types.Transaction{
+		newTx(addr1, 0, 1, types.LegacyTxType),
+		newTx(addr1, 1, 1, types.DynamicFeeTxType),
+	}
+
+	require.NoError(t, journal.rotate(txs[:1]))
+	require.NoError(t, journal.insert(txs[1]))
+	require.NoError(t, journal.close())
+
+	loadHashes := func() []types.Hash {
+		hashes := []types.Hash{}
+
+		_, _, err := journal.load(func(tx *types.Transaction) error {
+			hashes = append(hashes, tx.ComputeHash().Hash())
+
+			return nil
+		})
+		require.NoError(t, err)
+
+		return hashes
+	}
+
+	require.Equal(t, []types.Hash{txs[0].ComputeHash().Hash(), txs[1].ComputeHash().Hash()}, loadHashes())
+
+	// a truncated entry at the end is ignored
+	data, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data[:len(data)-10], 0600))
+
+	require.Equal(t, []types.Hash{txs[0].Hash()}, loadHashes())
+
+	// the rotation drops the transactions which are not passed
+	require.NoError(t, journal.rotate(nil))
+	require.NoError(t, journal.close())
+	require.Empty(t, loadHashes())
+}
+
+func TestTxPool_Journal(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "txpool.journal")
+
+	newPool := func() *TxPool {
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			getDefaultEnabledForks(),
+			defaultMockStore{DefaultHeader: mockHeader},
+			nil,
+			nil,
+			&Config{
+				PriceLimit:         defaultPriceLimit,
+				MaxSlots:           defaultMaxSlots,
+				MaxAccountEnqueued: defaultMaxAccountEnqueued,
+				JournalPath:        path,
+				RejournalInterval:  time.Hour,
+			},
+		)
+		require.NoError(t, err)
+
+		pool.SetSigner(signerLondon)
+		pool.Start()
+
+		return pool
+	}
+
+	sender := new(eoa).create(t)
+
+	txs := []*types.Transaction{
+		sender.signTx(t, newTx(sender.Address, 0, 1, types.LegacyTxType), signerLondon),
+		sender.signTx(t, newTx(sender.Address, 1, 1, types.LegacyTxType), signerLondon),
+		// enqueued because of the nonce gap
+		sender.signTx(t, newTx(sender.Address, 3, 1, types.LegacyTxType), signerLondon),
+	}
+
+	pool := newPool()
+
+	for _, tx := range txs {
+		require.NoError(t, pool.AddTx(tx))
+	}
+
+	// the gossiped transactions are not journaled
+	gossiped := new(eoa).create(t)
+	require.NoError(t, pool.addTx(gossip, gossiped.signTx(t, newTx(gossiped.Address, 0, 1, types.LegacyTxType), signerLondon)))
+
+	pool.Close()
+
+	// the restarted pool replays the local transactions
+	pool = newPool()
+	defer pool.Close()
+
+	for _, tx := range txs {
+		_, ok := pool.GetPendingTx(tx.Hash())
+		require.True(t, ok)
+	}
+
+	require.Len(t, pool.index.all, len(txs))
+	require.True(t, pool.locals.contains(sender.Address))
+
+	// the journal is regenerated from the pool on start
+	require.Equal(t, len(txs), len(pool.localTxs()))
+}
@@ -0,0 +1,173 @@
+package txpool
+
+import (
+	"sync"
+	"time"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// maxTrackedTxs is the maximum number of the transactions whose lifecycle is kept,
+	// the oldest tracked transaction is forgotten first
+	maxTrackedTxs = 4096
+
+	// maxLifecycleEvents is the maximum number of the events kept per transaction,
+	// the oldest events are discarded first
+	maxLifecycleEvents = 32
+)
+
+// reasons of the transactions leaving the pool
+const (
+	reasonExecutionFailed = "failed to execute in the block"
+	reasonTooManyDemotes  = "account demoted too many times"
+	reasonTooManySkips    = "account skipped in too many blocks"
+	reasonUnderpriced     = "evicted by a better priced transaction"
+	reasonReplaced        = "replaced by a transaction with the same nonce"
+	reasonNonceUsed       = "nonce already used in the chain"
+	reasonAdminRemoved    = "removed by the admin"
+	reasonAdminDropped    = "account dropped by the admin"
+)
+
+// TxLifecycleEvent is a single status transition of a transaction in the pool
+type TxLifecycleEvent struct {
+	Type   proto.EventType
+	Reason string
+	Time   time.Time
+}
+
+// TxLifecycle is the history of a transaction in the pool
+type TxLifecycle struct {
+	Hash types.Hash
+
+	// Events are the latest status transitions of the transaction (ascending by time)
+	Events []TxLifecycleEvent
+
+	// Demotions is the number of times the transaction has been demoted
+	Demotions uint64
+
+	// InPool reports whether the transaction is still in the pool
+	InPool bool
+
+	// NonceGap is the number of the account's missing nonces
+	// before the transaction can be promoted, only set if the transaction is in the pool
+	NonceGap uint64
+}
+
+// lifecycleTracker keeps the bounded lifecycle history of the latest transactions
+type lifecycleTracker struct {
+	sync.Mutex
+
+	limit int
+	txs   map[types.Hash]*TxLifecycle
+
+	// ring buffer of the tracked hashes in the order of their first event
+	order []types.Hash
+	next  int
+}
+
+func newLifecycleTracker(limit int) *lifecycleTracker {
+	return &lifecycleTracker{
+		limit: limit,
+		txs:   make(map[types.Hash]*TxLifecycle, limit),
+		order: make([]types.Hash, 0, limit),
+	}
+}
+
+// record appends the event to the lifecycle of the given transactions
+func (t *lifecycleTracker) record(eventType proto.EventType, reason string, hashes ...types.Hash) {
+	if len(hashes) == 0 {
+		return
+	}
+
+	t.Lock()
+	defer t.Unlock()
+
+	event := TxLifecycleEvent{
+		Type:   eventType,
+		Reason: reason,
+		Time:   time.Now().UTC(),
+	}
+
+	for _, hash := range hashes {
+		lifecycle, ok := t.txs[hash]
+		if !ok {
+			lifecycle = &TxLifecycle{Hash: hash}
+
+			t.track(lifecycle)
+		}
+
+		if len(lifecycle.Events) == maxLifecycleEvents {
+			lifecycle.Events = append(lifecycle.Events[:0], lifecycle.Events[1:]...)
+		}
+
+		lifecycle.Events = append(lifecycle.Events, event)
+
+		if eventType == proto.EventType_DEMOTED {
+			lifecycle.Demotions++
+		}
+	}
+}
+
+// track starts tracking the lifecycle, forgetting the oldest one if the limit is reached
+func (t *lifecycleTracker) track(lifecycle *TxLifecycle) {
+	if len(t.order) < t.limit {
+		t.order = append(t.order, lifecycle.Hash)
+	} else {
+		delete(t.txs, t.order[t.next])
+
+		t.order[t.next] = lifecycle.Hash
+		t.next = (t.next + 1) % t.limit
+	}
+
+	t.txs[lifecycle.Hash] = lifecycle
+}
+
+// get returns a copy of the lifecycle of the given transaction
+func (t *lifecycleTracker) get(hash types.Hash) (*TxLifecycle, bool) {
+	t.Lock()
+	defer t.Unlock()
+
+	lifecycle, ok := t.txs[hash]
+	if !ok {
+		return nil, false
+	}
+
+	return &TxLifecycle{
+		Hash:      lifecycle.Hash,
+		Events:    append([]TxLifecycleEvent(nil), lifecycle.Events...),
+		Demotions: lifecycle.Demotions,
+	}, true
+}
+
+// signalEvent alerts the subscribers of the event
+// and records it in the lifecycle of the given transactions
+func (p *TxPool) signalEvent(eventType proto.EventType, reason string, hashes ...types.Hash) {
+	p.lifecycles.record(eventType, reason, hashes...)
+	p.eventManager.signalEvent(eventType, hashes...)
+}
+
+// TxLifecycle returns the lifecycle of the transaction with the given hash,
+// along with the current nonce gap of its account if the transaction is still in the pool
+func (p *TxPool) TxLifecycle(hash types.Hash) (*TxLifecycle, bool) {
+	lifecycle, ok := p.lifecycles.get(hash)
+	if !ok {
+		return nil, false
+	}
+
+	tx, ok := p.index.get(hash)
+	if !ok {
+		return lifecycle, true
+	}
+
+	lifecycle.InPool = true
+
+	if account := p.accounts.get(tx.From()); account != nil {
+		if nextNonce := account.getNonce(); tx.Nonce() > nextNonce {
+			lifecycle.NonceGap = tx.Nonce() - nextNonce
+		}
+	}
+
+	return lifecycle, true
+}
@@ -0,0 +1,126 @@
+package txpool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestLifecycleTracker(t *testing.T) {
+	t.Parallel()
+
+	t.Run("forgets the oldest transactions", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newLifecycleTracker(2)
+		hashes := []types.Hash{types.StringToHash("0x1"), types.StringToHash("0x2"), types.StringToHash("0x3")}
+
+		tracker.record(proto.EventType_ADDED, "", hashes[0], hashes[1])
+		tracker.record(proto.EventType_PROMOTED, "", hashes[0])
+		tracker.record(proto.EventType_ADDED, "", hashes[2])
+
+		_, ok := tracker.get(hashes[0])
+		require.False(t, ok)
+
+		for _, hash := range hashes[1:] {
+			lifecycle, ok := tracker.get(hash)
+			require.True(t, ok)
+			require.Len(t, lifecycle.Events, 1)
+		}
+	})
+
+	t.Run("keeps the latest events and counts the demotions", func(t *testing.T) {
+		t.Parallel()
+
+		tracker := newLifecycleTracker(1)
+		hash := types.StringToHash("0x1")
+
+		for i := 0; i < maxLifecycleEvents+1; i++ {
+			tracker.record(proto.EventType_DEMOTED, "", hash)
+		}
+
+		tracker.record(proto.EventType_DROPPED, reasonTooManyDemotes, hash)
+
+		lifecycle, ok := tracker.get(hash)
+		require.True(t, ok)
+		require.Len(t, lifecycle.Events, maxLifecycleEvents)
+		require.Equal(t, uint64(maxLifecycleEvents+1), lifecycle.Demotions)
+
+		last := lifecycle.Events[maxLifecycleEvents-1]
+		require.Equal(t, proto.EventType_DROPPED, last.Type)
+		require.Equal(t, reasonTooManyDemotes, last.Reason)
+	})
+}
+
+func TestTxLifecycle(t *testing.T) {
+	t.Parallel()
+
+	// addTx adds the transaction and waits for its enqueue events to be recorded
+	addTx := func(t *testing.T, pool *TxPool, tx *types.Transaction) {
+		t.Helper()
+
+		require.NoError(t, pool.addTx(gossip, tx))
+		require.Eventually(t, func() bool {
+			lifecycle, ok := pool.TxLifecycle(tx.Hash())
+
+			return ok && len(lifecycle.Events) == 2
+		}, time.Second, 10*time.Millisecond)
+	}
+
+	t.Run("enqueued tx reports the nonce gap", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+		tx := newPricedTx(addr1, 2, 2)
+
+		addTx(t, pool, tx)
+
+		lifecycle, ok := pool.TxLifecycle(tx.Hash())
+		require.True(t, ok)
+		require.True(t, lifecycle.InPool)
+		require.Equal(t, uint64(2), lifecycle.NonceGap)
+		require.Equal(t, proto.EventType_ADDED, lifecycle.Events[0].Type)
+		require.Equal(t, proto.EventType_ENQUEUED, lifecycle.Events[1].Type)
+	})
+
+	t.Run("dropped account records the reason for all its txs", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+		txs := []*types.Transaction{newPricedTx(addr1, 0, 2), newPricedTx(addr1, 1, 2)}
+
+		for _, tx := range txs {
+			addTx(t, pool, tx)
+		}
+
+		pool.handlePromoteRequest(promoteRequest{account: addr1})
+		pool.Demote(txs[0])
+		pool.Drop(txs[0])
+
+		for _, tx := range txs {
+			lifecycle, ok := pool.TxLifecycle(tx.Hash())
+			require.True(t, ok)
+			require.False(t, lifecycle.InPool)
+
+			last := lifecycle.Events[len(lifecycle.Events)-1]
+			require.Equal(t, proto.EventType_DROPPED, last.Type)
+			require.Equal(t, reasonExecutionFailed, last.Reason)
+		}
+
+		lifecycle, _ := pool.TxLifecycle(txs[0].Hash())
+		require.Equal(t, uint64(1), lifecycle.Demotions)
+	})
+
+	t.Run("unknown tx", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newEvictionTestPool(t, 10)
+
+		_, ok := pool.TxLifecycle(types.StringToHash("0x1"))
+		require.False(t, ok)
+	})
+}
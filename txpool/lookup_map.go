@@ -10,6 +10,17 @@ import (
 type lookupMap struct {
 	sync.RWMutex
 	all map[types.Hash]*types.Transaction
+
+	// arrivals are the sequence numbers of the transactions arrival into the map
+	arrivals    map[types.Hash]uint64
+	lastArrival uint64
+}
+
+func newLookupMap() lookupMap {
+	return lookupMap{
+		all:      make(map[types.Hash]*types.Transaction),
+		arrivals: make(map[types.Hash]uint64),
+	}
 }
 
 // add inserts the given transaction into the map. Returns false
@@ -24,6 +35,9 @@ func (m *lookupMap) add(tx *types.Transaction) bool {
 
 	m.all[tx.Hash()] = tx
 
+	m.lastArrival++
+	m.arrivals[tx.Hash()] = m.lastArrival
+
 	return true
 }
 
@@ -34,6 +48,7 @@ func (m *lookupMap) remove(txs ...*types.Transaction) {
 
 	for _, tx := range txs {
 		delete(m.all, tx.Hash())
+		delete(m.arrivals, tx.Hash())
 	}
 }
 
@@ -49,3 +64,12 @@ func (m *lookupMap) get(hash types.Hash) (*types.Transaction, bool) {
 
 	return tx, true
 }
+
+// arrival returns the sequence number of the transaction arrival into the map,
+// or zero if the transaction is not in the map. [thread-safe]
+func (m *lookupMap) arrival(tx *types.Transaction) uint64 {
+	m.RLock()
+	defer m.RUnlock()
+
+	return m.arrivals[tx.Hash()]
+}
@@ -83,6 +83,35 @@ func (p *TxPool) Subscribe(
 	}
 }
 
+// TxStatus implements the GRPC tx status endpoint. Returns the lifecycle of the transaction in the pool
+func (p *TxPool) TxStatus(ctx context.Context, req *proto.TxStatusReq) (*proto.TxStatusResp, error) {
+	hash := types.Hash{}
+	if err := hash.UnmarshalText([]byte(req.TxHash)); err != nil {
+		return nil, err
+	}
+
+	lifecycle, ok := p.TxLifecycle(hash)
+	if !ok {
+		return nil, fmt.Errorf("transaction %s is not tracked by the pool", hash)
+	}
+
+	events := make([]*proto.TxStatusEvent, len(lifecycle.Events))
+	for i, event := range lifecycle.Events {
+		events[i] = &proto.TxStatusEvent{
+			Type:      event.Type,
+			Reason:    event.Reason,
+			Timestamp: event.Time.UnixMilli(),
+		}
+	}
+
+	return &proto.TxStatusResp{
+		Events:    events,
+		Demotions: lifecycle.Demotions,
+		InPool:    lifecycle.InPool,
+		NonceGap:  lifecycle.NonceGap,
+	}, nil
+}
+
 // TxPoolSubscribe subscribes to new events in the tx pool and returns subscription channel and unsubscribe fn
 func (p *TxPool) TxPoolSubscribe(request *proto.SubscribeRequest) (<-chan *proto.TxPoolEvent, func(), error) {
 	if err := request.ValidateAll(); err != nil {
@@ -0,0 +1,86 @@
+package txpool
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// FeeOrdering serves the transactions with the highest effective tip first
+	FeeOrdering = "fee"
+
+	// FIFOOrdering serves the transactions in the order of their arrival into the pool
+	FIFOOrdering = "fifo"
+)
+
+// OrderingPolicy defines the order in which the executable transactions
+// are served by the pool to the block builder
+type OrderingPolicy interface {
+	// Less reports whether the transaction a is served before the transaction b.
+	// Both are the next executable transactions of their accounts,
+	// the ties have to be broken so that the order doesn't depend on the accounts iteration order
+	Less(a, b *types.Transaction, env *OrderingEnv) bool
+
+	// MaxSenderTxs returns the maximum number of transactions of a single sender in a block,
+	// zero means no limit
+	MaxSenderTxs() uint64
+}
+
+// OrderingEnv is the pool state the transactions are ordered against
+type OrderingEnv struct {
+	// BaseFee is the base fee of the block being built
+	BaseFee *big.Int
+
+	// Arrival returns the sequence number of the transaction arrival into the pool
+	Arrival func(tx *types.Transaction) uint64
+}
+
+// NewOrderingPolicy returns the ordering policy with the given name,
+// the fee ordering is returned if the name is empty
+func NewOrderingPolicy(name string, maxSenderTxs uint64) (OrderingPolicy, error) {
+	switch name {
+	case "", FeeOrdering:
+		return &feeOrdering{maxSenderTxs: maxSenderTxs}, nil
+	case FIFOOrdering:
+		return &fifoOrdering{maxSenderTxs: maxSenderTxs}, nil
+	default:
+		return nil, fmt.Errorf("unknown transactions ordering policy: %s", name)
+	}
+}
+
+// feeOrdering orders the transactions by the effective tip (descending),
+// the ties are broken by the nonce and the arrival
+type feeOrdering struct {
+	maxSenderTxs uint64
+}
+
+func (o *feeOrdering) Less(a, b *types.Transaction, env *OrderingEnv) bool {
+	if c := cmp(a, b, env.BaseFee); c != 0 {
+		return c > 0
+	}
+
+	if a.Nonce() != b.Nonce() {
+		return a.Nonce() < b.Nonce()
+	}
+
+	return env.Arrival(a) < env.Arrival(b)
+}
+
+func (o *feeOrdering) MaxSenderTxs() uint64 {
+	return o.maxSenderTxs
+}
+
+// fifoOrdering orders the transactions by their arrival into the pool
+type fifoOrdering struct {
+	maxSenderTxs uint64
+}
+
+func (o *fifoOrdering) Less(a, b *types.Transaction, env *OrderingEnv) bool {
+	return env.Arrival(a) < env.Arrival(b)
+}
+
+func (o *fifoOrdering) MaxSenderTxs() uint64 {
+	return o.maxSenderTxs
+}
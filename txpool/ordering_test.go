@@ -0,0 +1,107 @@
+package txpool
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestNewOrderingPolicy(t *testing.T) {
+	t.Parallel()
+
+	policy, err := NewOrderingPolicy("", 0)
+	require.NoError(t, err)
+	require.IsType(t, &feeOrdering{}, policy)
+
+	policy, err = NewOrderingPolicy(FIFOOrdering, 3)
+	require.NoError(t, err)
+	require.IsType(t, &fifoOrdering{}, policy)
+	require.Equal(t, uint64(3), policy.MaxSenderTxs())
+
+	_, err = NewOrderingPolicy("random", 0)
+	require.ErrorContains(t, err, "unknown transactions ordering policy")
+}
+
+func TestOrderingPolicy_Executables(t *testing.T) {
+	t.Parallel()
+
+	// drain returns the executable transactions in the order they are served to the block builder
+	drain := func(pool *TxPool) []*types.Transaction {
+		var txs []*types.Transaction
+
+		pool.Prepare()
+
+		for tx := pool.Peek(); tx != nil; tx = pool.Peek() {
+			pool.Pop(tx)
+
+			txs = append(txs, tx)
+		}
+
+		return txs
+	}
+
+	cases := []struct {
+		name     string
+		policy   string
+		prices   []int64
+		expected []int
+	}{
+		{
+			name:     "fee ordering serves the highest tip first",
+			policy:   FeeOrdering,
+			prices:   []int64{2, 5, 3, 2},
+			expected: []int{1, 2, 0, 3},
+		},
+		{
+			name:     "fee ordering ties are served by arrival",
+			policy:   FeeOrdering,
+			prices:   []int64{4, 4, 4, 4},
+			expected: []int{0, 1, 2, 3},
+		},
+		{
+			name:     "fifo ordering serves by arrival",
+			policy:   FIFOOrdering,
+			prices:   []int64{2, 5, 3, 2},
+			expected: []int{0, 1, 2, 3},
+		},
+	}
+
+	for _, c := range cases {
+		c := c
+
+		t.Run(c.name, func(t *testing.T) {
+			t.Parallel()
+
+			pool := newEvictionTestPool(t, 10)
+
+			policy, err := NewOrderingPolicy(c.policy, 0)
+			require.NoError(t, err)
+
+			pool.SetOrderingPolicy(policy)
+
+			// the last transaction is the second one of the first account
+			senders := []types.Address{addr1, addr2, addr3, addr1}
+			nonces := []uint64{0, 0, 0, 1}
+
+			txs := make([]*types.Transaction, len(senders))
+
+			for i := range txs {
+				txs[i] = newPricedTx(senders[i], nonces[i], c.prices[i])
+				require.NoError(t, pool.addTx(gossip, txs[i]))
+			}
+
+			for _, addr := range []types.Address{addr1, addr2, addr3} {
+				pool.handlePromoteRequest(promoteRequest{account: addr})
+			}
+
+			expected := make([]*types.Transaction, len(c.expected))
+			for i, idx := range c.expected {
+				expected[i] = txs[idx]
+			}
+
+			require.Equal(t, expected, drain(pool))
+		})
+	}
+}
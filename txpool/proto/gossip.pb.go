@@ -0,0 +1,212 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.28.1
+// 	protoc        v3.21.7
+// source: txpool/proto/gossip.proto
+
+package proto
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+// TxnHashes announces (or requests) the transactions by their hashes
+type TxnHashes struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hashes [][]byte `protobuf:"bytes,1,rep,name=hashes,proto3" json:"hashes,omitempty"`
+}
+
+func (x *TxnHashes) Reset() {
+	*x = TxnHashes{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_gossip_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnHashes) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnHashes) ProtoMessage() {}
+
+func (x *TxnHashes) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_gossip_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnHashes.ProtoReflect.Descriptor instead.
+func (*TxnHashes) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_gossip_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *TxnHashes) GetHashes() [][]byte {
+	if x != nil {
+		return x.Hashes
+	}
+	return nil
+}
+
+// TxnBodies contains the RLP encoded transactions
+type TxnBodies struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Raw [][]byte `protobuf:"bytes,1,rep,name=raw,proto3" json:"raw,omitempty"`
+}
+
+func (x *TxnBodies) Reset() {
+	*x = TxnBodies{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_gossip_proto_msgTypes[1]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxnBodies) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxnBodies) ProtoMessage() {}
+
+func (x *TxnBodies) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_gossip_proto_msgTypes[1]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxnBodies.ProtoReflect.Descriptor instead.
+func (*TxnBodies) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_gossip_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *TxnBodies) GetRaw() [][]byte {
+	if x != nil {
+		return x.Raw
+	}
+	return nil
+}
+
+var File_txpool_proto_gossip_proto protoreflect.FileDescriptor
+
+var file_txpool_proto_gossip_proto_rawDesc = []byte{
+	0x0a, 0x19, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x2f, 0x67,
+	0x6f, 0x73, 0x73, 0x69, 0x70, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x02, 0x76, 0x31, 0x22,
+	0x23, 0x0a, 0x09, 0x54, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x12, 0x16, 0x0a, 0x06,
+	0x68, 0x61, 0x73, 0x68, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x06, 0x68, 0x61,
+	0x73, 0x68, 0x65, 0x73, 0x22, 0x1d, 0x0a, 0x09, 0x54, 0x78, 0x6e, 0x42, 0x6f, 0x64, 0x69, 0x65,
+	0x73, 0x12, 0x10, 0x0a, 0x03, 0x72, 0x61, 0x77, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0c, 0x52, 0x03,
+	0x72, 0x61, 0x77, 0x32, 0x38, 0x0a, 0x0d, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x47, 0x6f,
+	0x73, 0x73, 0x69, 0x70, 0x12, 0x27, 0x0a, 0x07, 0x47, 0x65, 0x74, 0x54, 0x78, 0x6e, 0x73, 0x12,
+	0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x6e, 0x48, 0x61, 0x73, 0x68, 0x65, 0x73, 0x1a, 0x0d,
+	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x6e, 0x42, 0x6f, 0x64, 0x69, 0x65, 0x73, 0x42, 0x0f, 0x5a,
+	0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x06,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+}
+
+var (
+	file_txpool_proto_gossip_proto_rawDescOnce sync.Once
+	file_txpool_proto_gossip_proto_rawDescData = file_txpool_proto_gossip_proto_rawDesc
+)
+
+func file_txpool_proto_gossip_proto_rawDescGZIP() []byte {
+	file_txpool_proto_gossip_proto_rawDescOnce.Do(func() {
+		file_txpool_proto_gossip_proto_rawDescData = protoimpl.X.CompressGZIP(file_txpool_proto_gossip_proto_rawDescData)
+	})
+	return file_txpool_proto_gossip_proto_rawDescData
+}
+
+var file_txpool_proto_gossip_proto_msgTypes = make([]protoimpl.MessageInfo, 2)
+var file_txpool_proto_gossip_proto_goTypes = []interface{}{
+	(*TxnHashes)(nil), // 0: v1.TxnHashes
+	(*TxnBodies)(nil), // 1: v1.TxnBodies
+}
+var file_txpool_proto_gossip_proto_depIdxs = []int32{
+	0, // 0: v1.TxnPoolGossip.GetTxns:input_type -> v1.TxnHashes
+	1, // 1: v1.TxnPoolGossip.GetTxns:output_type -> v1.TxnBodies
+	1, // [1:2] is the sub-list for method output_type
+	0, // [0:1] is the sub-list for method input_type
+	0, // [0:0] is the sub-list for extension type_name
+	0, // [0:0] is the sub-list for extension extendee
+	0, // [0:0] is the sub-list for field type_name
+}
+
+func init() { file_txpool_proto_gossip_proto_init() }
+func file_txpool_proto_gossip_proto_init() {
+	if File_txpool_proto_gossip_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_txpool_proto_gossip_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnHashes); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_gossip_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxnBodies); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: file_txpool_proto_gossip_proto_rawDesc,
+			NumEnums:      0,
+			NumMessages:   2,
+			NumExtensions: 0,
+			NumServices:   1,
+		},
+		GoTypes:           file_txpool_proto_gossip_proto_goTypes,
+		DependencyIndexes: file_txpool_proto_gossip_proto_depIdxs,
+		MessageInfos:      file_txpool_proto_gossip_proto_msgTypes,
+	}.Build()
+	File_txpool_proto_gossip_proto = out.File
+	file_txpool_proto_gossip_proto_rawDesc = nil
+	file_txpool_proto_gossip_proto_goTypes = nil
+	file_txpool_proto_gossip_proto_depIdxs = nil
+}
@@ -343,6 +343,190 @@ func (x *TxPoolEvent) GetTxHash() string {
 	return ""
 }
 
+type TxStatusReq struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	TxHash string `protobuf:"bytes,1,opt,name=txHash,proto3" json:"txHash,omitempty"`
+}
+
+func (x *TxStatusReq) Reset() {
+	*x = TxStatusReq{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[5]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStatusReq) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStatusReq) ProtoMessage() {}
+
+func (x *TxStatusReq) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[5]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxStatusReq.ProtoReflect.Descriptor instead.
+func (*TxStatusReq) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *TxStatusReq) GetTxHash() string {
+	if x != nil {
+		return x.TxHash
+	}
+	return ""
+}
+
+type TxStatusEvent struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Type   EventType `protobuf:"varint,1,opt,name=type,proto3,enum=v1.EventType" json:"type,omitempty"`
+	Reason string    `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	// Unix time of the event in milliseconds
+	Timestamp int64 `protobuf:"varint,3,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+}
+
+func (x *TxStatusEvent) Reset() {
+	*x = TxStatusEvent{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[6]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStatusEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStatusEvent) ProtoMessage() {}
+
+func (x *TxStatusEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[6]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxStatusEvent.ProtoReflect.Descriptor instead.
+func (*TxStatusEvent) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *TxStatusEvent) GetType() EventType {
+	if x != nil {
+		return x.Type
+	}
+	return EventType_ADDED
+}
+
+func (x *TxStatusEvent) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *TxStatusEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+type TxStatusResp struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Latest status transitions of the transaction
+	Events    []*TxStatusEvent `protobuf:"bytes,1,rep,name=events,proto3" json:"events,omitempty"`
+	Demotions uint64           `protobuf:"varint,2,opt,name=demotions,proto3" json:"demotions,omitempty"`
+	InPool    bool             `protobuf:"varint,3,opt,name=inPool,proto3" json:"inPool,omitempty"`
+	// Number of the account's missing nonces before the transaction can be promoted
+	NonceGap uint64 `protobuf:"varint,4,opt,name=nonceGap,proto3" json:"nonceGap,omitempty"`
+}
+
+func (x *TxStatusResp) Reset() {
+	*x = TxStatusResp{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_txpool_proto_operator_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TxStatusResp) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TxStatusResp) ProtoMessage() {}
+
+func (x *TxStatusResp) ProtoReflect() protoreflect.Message {
+	mi := &file_txpool_proto_operator_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TxStatusResp.ProtoReflect.Descriptor instead.
+func (*TxStatusResp) Descriptor() ([]byte, []int) {
+	return file_txpool_proto_operator_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *TxStatusResp) GetEvents() []*TxStatusEvent {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *TxStatusResp) GetDemotions() uint64 {
+	if x != nil {
+		return x.Demotions
+	}
+	return 0
+}
+
+func (x *TxStatusResp) GetInPool() bool {
+	if x != nil {
+		return x.InPool
+	}
+	return false
+}
+
+func (x *TxStatusResp) GetNonceGap() uint64 {
+	if x != nil {
+		return x.NonceGap
+	}
+	return 0
+}
+
 var File_txpool_proto_operator_proto protoreflect.FileDescriptor
 
 var file_txpool_proto_operator_proto_rawDesc = []byte{
@@ -374,27 +558,48 @@ var file_txpool_proto_operator_proto_rawDesc = []byte{
 	0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0e,
 	0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52,
 	0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x2a, 0x76, 0x0a,
-	0x09, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44,
-	0x44, 0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45,
-	0x44, 0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10,
-	0x02, 0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b,
-	0x0a, 0x07, 0x44, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50,
-	0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x05,
-	0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45,
-	0x55, 0x45, 0x44, 0x10, 0x06, 0x32, 0xa9, 0x01, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f,
-	0x6c, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61,
-	0x74, 0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x12, 0x0d, 0x2e, 0x76,
-	0x31, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x76, 0x31,
-	0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53,
-	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75,
-	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f,
-	0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30,
-	0x01, 0x42, 0x0f, 0x5a, 0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x22, 0x25, 0x0a,
+	0x0b, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x12, 0x16, 0x0a, 0x06,
+	0x74, 0x78, 0x48, 0x61, 0x73, 0x68, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74, 0x78,
+	0x48, 0x61, 0x73, 0x68, 0x22, 0x68, 0x0a, 0x0d, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x12, 0x21, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0e, 0x32, 0x0d, 0x2e, 0x76, 0x31, 0x2e, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x72, 0x65, 0x61, 0x73,
+	0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x72, 0x65, 0x61, 0x73, 0x6f, 0x6e,
+	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x03, 0x52, 0x09, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x22, 0x8b,
+	0x01, 0x0a, 0x0c, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x12,
+	0x29, 0x0a, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x52, 0x06, 0x65, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65,
+	0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x64,
+	0x65, 0x6d, 0x6f, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x69, 0x6e, 0x50, 0x6f,
+	0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x69, 0x6e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x12, 0x1a, 0x0a, 0x08, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x47, 0x61, 0x70, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x6e, 0x6f, 0x6e, 0x63, 0x65, 0x47, 0x61, 0x70, 0x2a, 0x76, 0x0a, 0x09,
+	0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x09, 0x0a, 0x05, 0x41, 0x44, 0x44,
+	0x45, 0x44, 0x10, 0x00, 0x12, 0x0c, 0x0a, 0x08, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55, 0x45, 0x44,
+	0x10, 0x01, 0x12, 0x0c, 0x0a, 0x08, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x02,
+	0x12, 0x0b, 0x0a, 0x07, 0x44, 0x52, 0x4f, 0x50, 0x50, 0x45, 0x44, 0x10, 0x03, 0x12, 0x0b, 0x0a,
+	0x07, 0x44, 0x45, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x04, 0x12, 0x13, 0x0a, 0x0f, 0x50, 0x52,
+	0x55, 0x4e, 0x45, 0x44, 0x5f, 0x50, 0x52, 0x4f, 0x4d, 0x4f, 0x54, 0x45, 0x44, 0x10, 0x05, 0x12,
+	0x13, 0x0a, 0x0f, 0x50, 0x52, 0x55, 0x4e, 0x45, 0x44, 0x5f, 0x45, 0x4e, 0x51, 0x55, 0x45, 0x55,
+	0x45, 0x44, 0x10, 0x06, 0x32, 0xd8, 0x01, 0x0a, 0x0f, 0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x6f, 0x72, 0x12, 0x37, 0x0a, 0x06, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x15, 0x2e, 0x76, 0x31, 0x2e,
+	0x54, 0x78, 0x6e, 0x50, 0x6f, 0x6f, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x12, 0x27, 0x0a, 0x06, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x12, 0x0d, 0x2e, 0x76, 0x31,
+	0x2e, 0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x71, 0x1a, 0x0e, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x64, 0x64, 0x54, 0x78, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x12, 0x34, 0x0a, 0x09, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x12, 0x14, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x0f, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x78, 0x50, 0x6f, 0x6f, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x30, 0x01,
+	0x12, 0x2d, 0x0a, 0x08, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x0f, 0x2e, 0x76,
+	0x31, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x71, 0x1a, 0x10, 0x2e,
+	0x76, 0x31, 0x2e, 0x54, 0x78, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x42,
+	0x0f, 0x5a, 0x0d, 0x2f, 0x74, 0x78, 0x70, 0x6f, 0x6f, 0x6c, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
 }
 
 var (
@@ -410,7 +615,7 @@ func file_txpool_proto_operator_proto_rawDescGZIP() []byte {
 }
 
 var file_txpool_proto_operator_proto_enumTypes = make([]protoimpl.EnumInfo, 1)
-var file_txpool_proto_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 5)
+var file_txpool_proto_operator_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
 var file_txpool_proto_operator_proto_goTypes = []interface{}{
 	(EventType)(0),            // 0: v1.EventType
 	(*AddTxnReq)(nil),         // 1: v1.AddTxnReq
@@ -418,24 +623,31 @@ var file_txpool_proto_operator_proto_goTypes = []interface{}{
 	(*TxnPoolStatusResp)(nil), // 3: v1.TxnPoolStatusResp
 	(*SubscribeRequest)(nil),  // 4: v1.SubscribeRequest
 	(*TxPoolEvent)(nil),       // 5: v1.TxPoolEvent
-	(*anypb.Any)(nil),         // 6: google.protobuf.Any
-	(*emptypb.Empty)(nil),     // 7: google.protobuf.Empty
+	(*TxStatusReq)(nil),       // 6: v1.TxStatusReq
+	(*TxStatusEvent)(nil),     // 7: v1.TxStatusEvent
+	(*TxStatusResp)(nil),      // 8: v1.TxStatusResp
+	(*anypb.Any)(nil),         // 9: google.protobuf.Any
+	(*emptypb.Empty)(nil),     // 10: google.protobuf.Empty
 }
 var file_txpool_proto_operator_proto_depIdxs = []int32{
-	6, // 0: v1.AddTxnReq.raw:type_name -> google.protobuf.Any
-	0, // 1: v1.SubscribeRequest.types:type_name -> v1.EventType
-	0, // 2: v1.TxPoolEvent.type:type_name -> v1.EventType
-	7, // 3: v1.TxnPoolOperator.Status:input_type -> google.protobuf.Empty
-	1, // 4: v1.TxnPoolOperator.AddTxn:input_type -> v1.AddTxnReq
-	4, // 5: v1.TxnPoolOperator.Subscribe:input_type -> v1.SubscribeRequest
-	3, // 6: v1.TxnPoolOperator.Status:output_type -> v1.TxnPoolStatusResp
-	2, // 7: v1.TxnPoolOperator.AddTxn:output_type -> v1.AddTxnResp
-	5, // 8: v1.TxnPoolOperator.Subscribe:output_type -> v1.TxPoolEvent
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	9,  // 0: v1.AddTxnReq.raw:type_name -> google.protobuf.Any
+	0,  // 1: v1.SubscribeRequest.types:type_name -> v1.EventType
+	0,  // 2: v1.TxPoolEvent.type:type_name -> v1.EventType
+	0,  // 3: v1.TxStatusEvent.type:type_name -> v1.EventType
+	7,  // 4: v1.TxStatusResp.events:type_name -> v1.TxStatusEvent
+	10, // 5: v1.TxnPoolOperator.Status:input_type -> google.protobuf.Empty
+	1,  // 6: v1.TxnPoolOperator.AddTxn:input_type -> v1.AddTxnReq
+	4,  // 7: v1.TxnPoolOperator.Subscribe:input_type -> v1.SubscribeRequest
+	6,  // 8: v1.TxnPoolOperator.TxStatus:input_type -> v1.TxStatusReq
+	3,  // 9: v1.TxnPoolOperator.Status:output_type -> v1.TxnPoolStatusResp
+	2,  // 10: v1.TxnPoolOperator.AddTxn:output_type -> v1.AddTxnResp
+	5,  // 11: v1.TxnPoolOperator.Subscribe:output_type -> v1.TxPoolEvent
+	8,  // 12: v1.TxnPoolOperator.TxStatus:output_type -> v1.TxStatusResp
+	9,  // [9:13] is the sub-list for method output_type
+	5,  // [5:9] is the sub-list for method input_type
+	5,  // [5:5] is the sub-list for extension type_name
+	5,  // [5:5] is the sub-list for extension extendee
+	0,  // [0:5] is the sub-list for field type_name
 }
 
 func init() { file_txpool_proto_operator_proto_init() }
@@ -504,6 +716,42 @@ func file_txpool_proto_operator_proto_init() {
 				return nil
 			}
 		}
+		file_txpool_proto_operator_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxStatusReq); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxStatusEvent); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_txpool_proto_operator_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TxStatusResp); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
@@ -511,7 +759,7 @@ func file_txpool_proto_operator_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_txpool_proto_operator_proto_rawDesc,
 			NumEnums:      1,
-			NumMessages:   5,
+			NumMessages:   8,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -615,3 +615,351 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = TxPoolEventValidationError{}
+
+// Validate checks the field values on TxStatusReq with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxStatusReq) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxStatusReq with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxStatusReqMultiError, or
+// nil if none found.
+func (m *TxStatusReq) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxStatusReq) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for TxHash
+
+	if len(errors) > 0 {
+		return TxStatusReqMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxStatusReqMultiError is an error wrapping multiple validation errors
+// returned by TxStatusReq.ValidateAll() if the designated constraints aren't
+// met.
+type TxStatusReqMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxStatusReqMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxStatusReqMultiError) AllErrors() []error { return m }
+
+// TxStatusReqValidationError is the validation error returned by
+// TxStatusReq.Validate if the designated constraints aren't met.
+type TxStatusReqValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxStatusReqValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxStatusReqValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxStatusReqValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxStatusReqValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxStatusReqValidationError) ErrorName() string { return "TxStatusReqValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxStatusReqValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxStatusReq.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxStatusReqValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxStatusReqValidationError{}
+
+// Validate checks the field values on TxStatusEvent with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxStatusEvent) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxStatusEvent with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TxStatusEventMultiError, or nil if none found.
+func (m *TxStatusEvent) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxStatusEvent) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Type
+
+	// no validation rules for Reason
+
+	// no validation rules for Timestamp
+
+	if len(errors) > 0 {
+		return TxStatusEventMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxStatusEventMultiError is an error wrapping multiple validation errors
+// returned by TxStatusEvent.ValidateAll() if the designated constraints
+// aren't met.
+type TxStatusEventMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxStatusEventMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxStatusEventMultiError) AllErrors() []error { return m }
+
+// TxStatusEventValidationError is the validation error returned by
+// TxStatusEvent.Validate if the designated constraints aren't met.
+type TxStatusEventValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxStatusEventValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxStatusEventValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxStatusEventValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxStatusEventValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxStatusEventValidationError) ErrorName() string { return "TxStatusEventValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxStatusEventValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxStatusEvent.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxStatusEventValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxStatusEventValidationError{}
+
+// Validate checks the field values on TxStatusResp with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TxStatusResp) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TxStatusResp with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in TxStatusRespMultiError, or
+// nil if none found.
+func (m *TxStatusResp) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TxStatusResp) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetEvents() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, TxStatusRespValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, TxStatusRespValidationError{
+						field:  fmt.Sprintf("Events[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return TxStatusRespValidationError{
+					field:  fmt.Sprintf("Events[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Demotions
+
+	// no validation rules for InPool
+
+	// no validation rules for NonceGap
+
+	if len(errors) > 0 {
+		return TxStatusRespMultiError(errors)
+	}
+
+	return nil
+}
+
+// TxStatusRespMultiError is an error wrapping multiple validation errors
+// returned by TxStatusResp.ValidateAll() if the designated constraints
+// aren't met.
+type TxStatusRespMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TxStatusRespMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TxStatusRespMultiError) AllErrors() []error { return m }
+
+// TxStatusRespValidationError is the validation error returned by
+// TxStatusResp.Validate if the designated constraints aren't met.
+type TxStatusRespValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TxStatusRespValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TxStatusRespValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TxStatusRespValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TxStatusRespValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TxStatusRespValidationError) ErrorName() string { return "TxStatusRespValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TxStatusRespValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTxStatusResp.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TxStatusRespValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TxStatusRespValidationError{}
@@ -29,6 +29,8 @@ type TxnPoolOperatorClient interface {
 	AddTxn(ctx context.Context, in *AddTxnReq, opts ...grpc.CallOption) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (TxnPoolOperator_SubscribeClient, error)
+	// TxStatus returns the lifecycle of the transaction in the pool
+	TxStatus(ctx context.Context, in *TxStatusReq, opts ...grpc.CallOption) (*TxStatusResp, error)
 }
 
 type txnPoolOperatorClient struct {
@@ -89,6 +91,15 @@ func (x *txnPoolOperatorSubscribeClient) Recv() (*TxPoolEvent, error) {
 	return m, nil
 }
 
+func (c *txnPoolOperatorClient) TxStatus(ctx context.Context, in *TxStatusReq, opts ...grpc.CallOption) (*TxStatusResp, error) {
+	out := new(TxStatusResp)
+	err := c.cc.Invoke(ctx, "/v1.TxnPoolOperator/TxStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // TxnPoolOperatorServer is the server API for TxnPoolOperator service.
 // All implementations must embed UnimplementedTxnPoolOperatorServer
 // for forward compatibility
@@ -99,6 +110,8 @@ type TxnPoolOperatorServer interface {
 	AddTxn(context.Context, *AddTxnReq) (*AddTxnResp, error)
 	// Subscribe subscribes for new events in the txpool
 	Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error
+	// TxStatus returns the lifecycle of the transaction in the pool
+	TxStatus(context.Context, *TxStatusReq) (*TxStatusResp, error)
 	mustEmbedUnimplementedTxnPoolOperatorServer()
 }
 
@@ -115,6 +128,9 @@ func (UnimplementedTxnPoolOperatorServer) AddTxn(context.Context, *AddTxnReq) (*
 func (UnimplementedTxnPoolOperatorServer) Subscribe(*SubscribeRequest, TxnPoolOperator_SubscribeServer) error {
 	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
 }
+func (UnimplementedTxnPoolOperatorServer) TxStatus(context.Context, *TxStatusReq) (*TxStatusResp, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxStatus not implemented")
+}
 func (UnimplementedTxnPoolOperatorServer) mustEmbedUnimplementedTxnPoolOperatorServer() {}
 
 // UnsafeTxnPoolOperatorServer may be embedded to opt out of forward compatibility for this service.
@@ -185,6 +201,24 @@ func (x *txnPoolOperatorSubscribeServer) Send(m *TxPoolEvent) error {
 	return x.ServerStream.SendMsg(m)
 }
 
+func _TxnPoolOperator_TxStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TxStatusReq)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TxnPoolOperatorServer).TxStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/v1.TxnPoolOperator/TxStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TxnPoolOperatorServer).TxStatus(ctx, req.(*TxStatusReq))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // TxnPoolOperator_ServiceDesc is the grpc.ServiceDesc for TxnPoolOperator service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -200,6 +234,10 @@ var TxnPoolOperator_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "AddTxn",
 			Handler:    _TxnPoolOperator_AddTxn_Handler,
 		},
+		{
+			MethodName: "TxStatus",
+			Handler:    _TxnPoolOperator_TxStatus_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
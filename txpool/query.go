@@ -58,3 +58,25 @@ func (p *TxPool) GetBaseFee() uint64 {
 func (p *TxPool) SetBaseFee(header *types.Header) {
 	atomic.StoreUint64(&p.baseFee, p.store.CalculateBaseFee(header))
 }
+
+// GetAccountTxs gets pending and queued transactions of the given account
+func (p *TxPool) GetAccountTxs(addr types.Address) (promoted, enqueued []*types.Transaction) {
+	account := p.accounts.get(addr)
+	if account == nil {
+		return nil, nil
+	}
+
+	account.promoted.lock(false)
+	defer account.promoted.unlock()
+
+	account.enqueued.lock(false)
+	defer account.enqueued.unlock()
+
+	promoted = make([]*types.Transaction, len(account.promoted.queue))
+	copy(promoted, account.promoted.queue)
+
+	enqueued = make([]*types.Transaction, len(account.enqueued.queue))
+	copy(enqueued, account.enqueued.queue)
+
+	return promoted, enqueued
+}
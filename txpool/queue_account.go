@@ -95,6 +95,26 @@ func (q *accountQueue) pop() *types.Transaction {
 	return transaction
 }
 
+// removeHighest removes the transaction if it has the highest nonce in the queue.
+// Returns true if the transaction is removed
+func (q *accountQueue) removeHighest(tx *types.Transaction) bool {
+	highest := -1
+
+	for i, x := range q.queue {
+		if highest == -1 || x.Nonce() > q.queue[highest].Nonce() {
+			highest = i
+		}
+	}
+
+	if highest == -1 || q.queue[highest].Hash() != tx.Hash() {
+		return false
+	}
+
+	heap.Remove(&q.queue, highest)
+
+	return true
+}
+
 // length returns the number of transactions in the queue.
 func (q *accountQueue) length() uint64 {
 	return uint64(q.queue.Len())
@@ -25,6 +25,23 @@ func newPricesQueue(baseFee uint64, initialTxs []*types.Transaction) *pricedQueu
 	return q
 }
 
+// newOrderedQueue creates the queue with initial transactions ordered by the given policy
+func newOrderedQueue(ordering OrderingPolicy, env *OrderingEnv, initialTxs []*types.Transaction) *pricedQueue {
+	q := &pricedQueue{
+		queue: &maxPriceQueue{
+			baseFee: env.BaseFee,
+			txs:     initialTxs,
+			less: func(a, b *types.Transaction) bool {
+				return ordering.Less(a, b, env)
+			},
+		},
+	}
+
+	heap.Init(q.queue)
+
+	return q
+}
+
 // Pushes the given transactions onto the queue.
 func (q *pricedQueue) push(tx *types.Transaction) {
 	heap.Push(q.queue, tx)
@@ -50,10 +67,11 @@ func (q *pricedQueue) length() int {
 	return q.queue.Len()
 }
 
-// transactions sorted by gas price (descending)
+// transactions sorted by gas price (descending), or by the custom ordering if less is set
 type maxPriceQueue struct {
 	baseFee *big.Int
 	txs     []*types.Transaction
+	less    func(a, b *types.Transaction) bool
 }
 
 /* Queue methods required by the heap interface */
@@ -95,6 +113,10 @@ func (q *maxPriceQueue) Pop() interface{} {
 // @see https://github.com/etclabscore/core-geth/blob/4e2b0e37f89515a4e7b6bafaa40910a296cb38c0/core/txpool/list.go#L458
 // for details why is something implemented like it is
 func (q *maxPriceQueue) Less(i, j int) bool {
+	if q.less != nil {
+		return q.less(q.txs[i], q.txs[j])
+	}
+
 	switch cmp(q.txs[i], q.txs[j], q.baseFee) {
 	case -1:
 		return false
@@ -0,0 +1,152 @@
+package txpool
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/armon/go-metrics"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"golang.org/x/time/rate"
+
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+const (
+	// rateLimitBurstFactor is the number of seconds worth of transactions or gossip messages
+	// which can be submitted at once by a sender or a peer with no tokens spent before
+	rateLimitBurstFactor = 10
+
+	// maxPeerViolations is the number of the rate limited gossip messages
+	// within the violations window after which the peer is disconnected
+	maxPeerViolations = 100
+
+	// peerViolationsWindow is the time window in which the rate limit violations of a peer are counted
+	peerViolationsWindow = time.Minute
+)
+
+var (
+	ErrSenderRateLimited = errors.New("sender transactions rate limit exceeded")
+	ErrPeerRateLimited   = errors.New("peer transactions rate limit exceeded")
+)
+
+// rateLimiter is a set of token buckets keyed by the transactions source (sender or peer).
+// A nil rate limiter allows everything
+type rateLimiter[K comparable] struct {
+	sync.Mutex
+
+	limit   rate.Limit
+	burst   int
+	buckets map[K]*rateBucket
+}
+
+// rateBucket is the token bucket of a single source,
+// along with the count of its recent rate limit violations
+type rateBucket struct {
+	limiter    *rate.Limiter
+	violations uint64
+	since      time.Time
+}
+
+// newRateLimiter returns the rate limiter allowing the given number of transactions per second,
+// or nil if the limit is zero (disabled)
+func newRateLimiter[K comparable](perSecond uint64) *rateLimiter[K] {
+	if perSecond == 0 {
+		return nil
+	}
+
+	return &rateLimiter[K]{
+		limit:   rate.Limit(perSecond),
+		burst:   int(perSecond * rateLimitBurstFactor),
+		buckets: make(map[K]*rateBucket),
+	}
+}
+
+// allow spends a token of the given source. If there are no tokens left,
+// it returns false along with the number of violations within the violations window
+func (l *rateLimiter[K]) allow(key K) (bool, uint64) {
+	if l == nil {
+		return true, 0
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	bucket, ok := l.buckets[key]
+	if !ok {
+		bucket = &rateBucket{limiter: rate.NewLimiter(l.limit, l.burst)}
+		l.buckets[key] = bucket
+	}
+
+	now := time.Now()
+
+	if bucket.limiter.AllowN(now, 1) {
+		return true, 0
+	}
+
+	if now.Sub(bucket.since) > peerViolationsWindow {
+		bucket.since = now
+		bucket.violations = 0
+	}
+
+	bucket.violations++
+
+	return false, bucket.violations
+}
+
+// prune removes the buckets of the sources which have been idle long enough to refill the bucket
+func (l *rateLimiter[K]) prune() {
+	if l == nil {
+		return
+	}
+
+	l.Lock()
+	defer l.Unlock()
+
+	now := time.Now()
+
+	for key, bucket := range l.buckets {
+		if bucket.limiter.TokensAt(now) >= float64(l.burst) {
+			delete(l.buckets, key)
+		}
+	}
+}
+
+// allowSender checks the rate limit of the gossiped transaction sender,
+// the priority accounts are not rate limited
+func (p *TxPool) allowSender(from types.Address) error {
+	if p.priority.contains(from) {
+		return nil
+	}
+
+	if ok, _ := p.senderLimiter.allow(from); !ok {
+		metrics.IncrCounter([]string{txPoolMetrics, "sender_rate_limited_tx"}, 1)
+
+		return ErrSenderRateLimited
+	}
+
+	return nil
+}
+
+// allowPeer checks the rate limit of the peer's gossip messages. A token is spent for every announcement
+// the peer delivers, rather than for every announced transaction, since an honest peer relays the transactions
+// of the whole network to us, but batches the ones it has fetched in a single announcement.
+// On the full transactions topic, a token is spent for every transaction the peer has published,
+// since the message author is signed, and the relaying peers aren't charged for it.
+// The peer persistently exceeding the limit is disconnected
+func (p *TxPool) allowPeer(peerID peer.ID) error {
+	ok, violations := p.peerLimiter.allow(peerID)
+	if ok {
+		return nil
+	}
+
+	metrics.IncrCounter([]string{txPoolMetrics, "peer_rate_limited_tx"}, 1)
+
+	if violations%maxPeerViolations == 0 && p.gossipPeers != nil {
+		metrics.IncrCounter([]string{txPoolMetrics, "penalized_peers"}, 1)
+
+		p.gossipPeers.penalize(peerID, ErrPeerRateLimited.Error())
+	}
+
+	return ErrPeerRateLimited
+}
@@ -0,0 +1,253 @@
+package txpool
+
+import (
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang/protobuf/ptypes/any"
+	"github.com/hashicorp/go-hclog"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/time/rate"
+
+	"github.com/0xPolygon/polygon-edge/txpool/proto"
+	"github.com/0xPolygon/polygon-edge/types"
+)
+
+func TestRateLimiter(t *testing.T) {
+	t.Parallel()
+
+	t.Run("disabled", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newRateLimiter[types.Address](0)
+		require.Nil(t, limiter)
+
+		for i := 0; i < 100; i++ {
+			ok, _ := limiter.allow(addr1)
+			require.True(t, ok)
+		}
+
+		limiter.prune()
+	})
+
+	t.Run("allows the burst and counts the violations", func(t *testing.T) {
+		t.Parallel()
+
+		limiter := newRateLimiter[types.Address](1)
+
+		for i := 0; i < rateLimitBurstFactor; i++ {
+			ok, _ := limiter.allow(addr1)
+			require.True(t, ok)
+		}
+
+		for i := uint64(1); i <= 3; i++ {
+			ok, violations := limiter.allow(addr1)
+			require.False(t, ok)
+			require.Equal(t, i, violations)
+		}
+
+		// the other sources have their own buckets
+		ok, _ := limiter.allow(addr2)
+		require.True(t, ok)
+
+		// only the idle buckets are pruned
+		limiter.buckets[addr3] = &rateBucket{limiter: rate.NewLimiter(limiter.limit, limiter.burst)}
+		limiter.prune()
+
+		require.Len(t, limiter.buckets, 2)
+		require.NotContains(t, limiter.buckets, addr3)
+	})
+}
+
+func TestSenderRateLimit(t *testing.T) {
+	t.Parallel()
+
+	pool := newGossipTestPool(t)
+	pool.senderLimiter = newRateLimiter[types.Address](1)
+	pool.priority.add(addr2)
+
+	txs := make([]*types.Transaction, rateLimitBurstFactor)
+
+	for nonce := range txs {
+		txs[nonce] = newTx(addr1, uint64(nonce), 1, types.LegacyTxType)
+		require.NoError(t, pool.addTx(gossip, txs[nonce]))
+	}
+
+	// the known transaction is rejected without spending a token
+	require.ErrorIs(t, pool.addTx(gossip, txs[rateLimitBurstFactor-1]), ErrAlreadyKnown)
+
+	require.ErrorIs(t,
+		pool.addTx(gossip, newTx(addr1, rateLimitBurstFactor, 1, types.LegacyTxType)),
+		ErrSenderRateLimited,
+	)
+
+	// the local transactions are not rate limited
+	require.NoError(t, pool.addTx(local, newTx(addr1, rateLimitBurstFactor, 1, types.LegacyTxType)))
+
+	// the priority accounts are not rate limited
+	for nonce := uint64(0); nonce <= rateLimitBurstFactor; nonce++ {
+		require.NoError(t, pool.addTx(gossip, newTx(addr2, nonce, 1, types.LegacyTxType)))
+	}
+}
+
+func TestPeerRateLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("announcements", func(t *testing.T) {
+		t.Parallel()
+
+		source := newGossipTestPool(t)
+		pool := newGossipTestPool(t)
+		pool.peerLimiter = newRateLimiter[peer.ID](1)
+
+		peers := &mockGossipPeers{source: source}
+		pool.gossipPeers = peers
+
+		relay := peer.ID("relay")
+		senders := int64(0)
+
+		announce := func(count int) (*proto.TxnHashes, []*types.Transaction) {
+			announcement := &proto.TxnHashes{}
+			txs := make([]*types.Transaction, count)
+
+			// every transaction has its own sender and nonce, so the hashes differ and the enqueued limit is not reached
+			for i := range txs {
+				senders++
+
+				from := types.BytesToAddress(big.NewInt(senders).Bytes())
+				txs[i] = newTx(from, uint64(senders), 1, types.LegacyTxType)
+				require.NoError(t, source.addTx(gossip, txs[i]))
+
+				announcement.Hashes = append(announcement.Hashes, txs[i].Hash().Bytes())
+			}
+
+			return announcement, txs
+		}
+
+		// a single announcement spends a single token, however many transactions it relays
+		announcement, _ := announce(2 * rateLimitBurstFactor)
+		pool.handleTxAnnouncement(announcement, relay)
+
+		for i := 1; i < rateLimitBurstFactor; i++ {
+			announcement, _ = announce(1)
+			pool.handleTxAnnouncement(announcement, relay)
+		}
+
+		require.Len(t, pool.index.all, 3*rateLimitBurstFactor-1)
+
+		// the announcement of the known transactions only doesn't spend a token
+		pool.handleTxAnnouncement(announcement, relay)
+
+		rejected, txs := announce(1)
+		pool.handleTxAnnouncement(rejected, relay)
+
+		_, ok := pool.index.get(txs[0].Hash())
+		require.False(t, ok)
+
+		// the same announcement is accepted from the other peer
+		pool.handleTxAnnouncement(rejected, peer.ID("other"))
+
+		_, ok = pool.index.get(txs[0].Hash())
+		require.True(t, ok)
+		require.Empty(t, peers.penalized)
+
+		// the peer persistently exceeding the limit is penalized
+		for i := uint64(1); i < maxPeerViolations; i++ {
+			announcement, _ = announce(1)
+			pool.handleTxAnnouncement(announcement, relay)
+		}
+
+		require.Equal(t, []peer.ID{relay}, peers.penalized)
+	})
+
+	t.Run("full transactions", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newGossipTestPool(t)
+		pool.SetSigner(signerLondon)
+		pool.peerLimiter = newRateLimiter[peer.ID](1)
+
+		sender := new(eoa).create(t)
+		author := peer.ID("author")
+
+		publish := func(nonce uint64, from peer.ID) *types.Transaction {
+			tx := sender.signTx(t, newTx(sender.Address, nonce, 1, types.LegacyTxType), signerLondon)
+
+			pool.addGossipTx(&proto.Txn{Raw: &any.Any{Value: tx.MarshalRLP()}}, from)
+
+			return tx.ComputeHash()
+		}
+
+		for nonce := uint64(0); nonce < rateLimitBurstFactor; nonce++ {
+			publish(nonce, author)
+		}
+
+		require.Len(t, pool.index.all, rateLimitBurstFactor)
+
+		tx := publish(rateLimitBurstFactor, author)
+
+		_, ok := pool.index.get(tx.Hash())
+		require.False(t, ok)
+
+		// the same transaction is accepted when published by the other peer
+		tx = publish(rateLimitBurstFactor, peer.ID("other"))
+
+		_, ok = pool.index.get(tx.Hash())
+		require.True(t, ok)
+	})
+}
+
+func TestTxPool_JournalNotRateLimited(t *testing.T) {
+	t.Parallel()
+
+	const perSecond = 1
+
+	path := filepath.Join(t.TempDir(), "txpool.journal")
+
+	newPool := func() *TxPool {
+		pool, err := NewTxPool(
+			hclog.NewNullLogger(),
+			getDefaultEnabledForks(),
+			defaultMockStore{DefaultHeader: mockHeader},
+			nil,
+			nil,
+			&Config{
+				PriceLimit:         defaultPriceLimit,
+				MaxSlots:           defaultMaxSlots,
+				MaxAccountEnqueued: defaultMaxAccountEnqueued,
+				JournalPath:        path,
+				RejournalInterval:  time.Hour,
+				SenderRateLimit:    perSecond,
+			},
+		)
+		require.NoError(t, err)
+
+		pool.SetSigner(signerLondon)
+		pool.Start()
+
+		return pool
+	}
+
+	sender := new(eoa).create(t)
+
+	// more transactions than the burst of the sender rate limit
+	txs := make([]*types.Transaction, 2*perSecond*rateLimitBurstFactor)
+
+	pool := newPool()
+
+	for nonce := range txs {
+		txs[nonce] = sender.signTx(t, newTx(sender.Address, uint64(nonce), 1, types.LegacyTxType), signerLondon)
+		require.NoError(t, pool.AddTx(txs[nonce]))
+	}
+
+	pool.Close()
+
+	// the restarted pool replays all the journaled transactions
+	pool = newPool()
+	defer pool.Close()
+
+	require.Len(t, pool.index.all, len(txs))
+}
@@ -4,11 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"math/big"
+	"sort"
+	"sync"
 	"sync/atomic"
 	"time"
 
 	"github.com/armon/go-metrics"
-	"github.com/golang/protobuf/ptypes/any"
 	"github.com/hashicorp/go-hclog"
 	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/grpc"
@@ -16,6 +17,7 @@ import (
 	"github.com/0xPolygon/polygon-edge/blockchain"
 	"github.com/0xPolygon/polygon-edge/chain"
 	"github.com/0xPolygon/polygon-edge/network"
+	networkGrpc "github.com/0xPolygon/polygon-edge/network/grpc"
 	"github.com/0xPolygon/polygon-edge/state"
 	"github.com/0xPolygon/polygon-edge/state/runtime"
 	"github.com/0xPolygon/polygon-edge/txpool/proto"
@@ -35,6 +37,9 @@ const (
 
 	pruningCooldown = 5000 * time.Millisecond
 
+	// defaultRejournalInterval is the time interval after which the journal is regenerated if not configured
+	defaultRejournalInterval = time.Hour
+
 	// txPoolMetrics is a prefix used for txpool-related metrics
 	txPoolMetrics = "txpool"
 )
@@ -89,6 +94,7 @@ type store interface {
 	Header() *types.Header
 	GetNonce(root types.Hash, addr types.Address) uint64
 	GetBalance(root types.Hash, addr types.Address) (*big.Int, error)
+	GetStorage(root types.Hash, addr types.Address, slot types.Hash) types.Hash
 	GetBlockByHash(types.Hash, bool) (*types.Block, bool)
 	CalculateBaseFee(parent *types.Header) uint64
 }
@@ -103,6 +109,21 @@ type Config struct {
 	MaxAccountEnqueued uint64
 	ChainID            *big.Int
 	PeerID             peer.ID
+
+	// JournalPath is the file of the local transactions journal, the journal is disabled if it's empty
+	JournalPath string
+	// RejournalInterval is the time interval after which the journal is regenerated from the pool
+	RejournalInterval time.Duration
+	// PriorityAccounts are the accounts whose transactions are exempt from the price limit,
+	// the enqueued limit and the eviction, and are executed before the others
+	PriorityAccounts []types.Address
+	// SenderRateLimit is the number of gossiped transactions per second accepted from a single sender,
+	// the limit is disabled if it's zero
+	SenderRateLimit uint64
+	// PeerRateLimit is the number of gossip messages per second accepted from a single peer,
+	// i.e. the announcements delivered by the peer and the full transactions published by it,
+	// the limit is disabled if it's zero
+	PeerRateLimit uint64
 }
 
 /* All requests are passed to the main loop
@@ -134,6 +155,8 @@ type promoteRequest struct {
 // the pool generates a queue of "executable" transactions. These
 // transactions are the first-in-line of some promoted queue,
 // ready to be written to the state (primaries).
+// The primaries of the priority accounts are kept in a separate queue
+// which is always drained first.
 type TxPool struct {
 	logger hclog.Logger
 	signer signer
@@ -146,12 +169,31 @@ type TxPool struct {
 	// all the primaries sorted by max gas price
 	executables *pricedQueue
 
+	// the primaries of the priority accounts sorted by max gas price
+	priorityExecutables *pricedQueue
+
 	// lookup map keeping track of all
 	// transactions present in the pool
 	index lookupMap
 
-	// networking stack
-	topic *network.Topic
+	// networking stack, the full transactions topic
+	// is used only if some of the peers don't support the announcements
+	topic         *network.Topic
+	announceTopic *network.Topic
+	gossipStream  *networkGrpc.GrpcStream
+	gossipPeers   txGossipPeers
+
+	// fetching are the hashes of the announced transactions being fetched
+	fetching *fetchingSet
+
+	// bundles are the transaction bundles waiting for their target block
+	bundles *bundleQueue
+
+	// lifecycles is the bounded history of the transactions in the pool
+	lifecycles *lifecycleTracker
+
+	// conditionals are the conditions of the conditional transactions in the pool
+	conditionals *conditionsMap
 
 	// gauge for measuring pool capacity
 	gauge slotGauge
@@ -190,6 +232,26 @@ type TxPool struct {
 
 	// localPeerID is the peer ID of the local node that is running the txpool
 	localPeerID peer.ID
+
+	// locals are the senders of the transactions submitted through the local endpoints
+	locals *accountSet
+
+	// priority are the whitelisted accounts served before the price-ordered executables
+	priority *accountSet
+
+	// journal keeps the local transactions across the node restarts (nil if disabled)
+	journal     *txJournal
+	journalLock sync.Mutex
+
+	// rejournalInterval is the time interval after which the journal is regenerated
+	rejournalInterval time.Duration
+
+	// senderLimiter and peerLimiter rate limit the incoming transactions (nil if disabled)
+	senderLimiter *rateLimiter[types.Address]
+	peerLimiter   *rateLimiter[peer.ID]
+
+	// ordering is the order in which the executable transactions are served
+	ordering OrderingPolicy
 }
 
 // NewTxPool returns a new pool for processing incoming transactions.
@@ -202,16 +264,28 @@ func NewTxPool(
 	config *Config,
 ) (*TxPool, error) {
 	pool := &TxPool{
-		logger:      logger.Named("txpool"),
-		forks:       forks,
-		store:       store,
-		executables: newPricesQueue(0, nil),
-		accounts:    accountsMap{maxEnqueuedLimit: config.MaxAccountEnqueued},
-		index:       lookupMap{all: make(map[types.Hash]*types.Transaction)},
-		gauge:       slotGauge{height: 0, max: config.MaxSlots},
-		priceLimit:  config.PriceLimit,
-		chainID:     config.ChainID,
-		localPeerID: config.PeerID,
+		logger:              logger.Named("txpool"),
+		forks:               forks,
+		store:               store,
+		executables:         newPricesQueue(0, nil),
+		priorityExecutables: newPricesQueue(0, nil),
+		accounts:            accountsMap{maxEnqueuedLimit: config.MaxAccountEnqueued},
+		index:               newLookupMap(),
+		gauge:               slotGauge{height: 0, max: config.MaxSlots},
+		priceLimit:          config.PriceLimit,
+		chainID:             config.ChainID,
+		localPeerID:         config.PeerID,
+		locals:              newAccountSet(),
+		priority:            newAccountSet(),
+		fetching:            newFetchingSet(),
+		bundles:             newBundleQueue(),
+		lifecycles:          newLifecycleTracker(maxTrackedTxs),
+		conditionals:        newConditionsMap(),
+		senderLimiter:       newRateLimiter[types.Address](config.SenderRateLimit),
+		peerLimiter:         newRateLimiter[peer.ID](config.PeerRateLimit),
+		ordering:            &feeOrdering{},
+
+		rejournalInterval: config.RejournalInterval,
 
 		//	main loop channels
 		promoteReqCh: make(chan promoteRequest),
@@ -219,21 +293,26 @@ func NewTxPool(
 		shutdownCh:   make(chan struct{}),
 	}
 
+	for _, addr := range config.PriorityAccounts {
+		pool.priority.add(addr)
+	}
+
 	// Attach the event manager
 	pool.eventManager = newEventManager(pool.logger)
 
-	if network != nil {
-		// subscribe to the gossip protocol
-		topic, err := network.NewTopic(topicNameV1, &proto.Txn{})
-		if err != nil {
-			return nil, err
-		}
+	if config.JournalPath != "" {
+		pool.journal = newTxJournal(config.JournalPath)
 
-		if subscribeErr := topic.Subscribe(pool.addGossipTx); subscribeErr != nil {
-			return nil, fmt.Errorf("unable to subscribe to gossip topic, %w", subscribeErr)
+		if pool.rejournalInterval <= 0 {
+			pool.rejournalInterval = defaultRejournalInterval
 		}
+	}
 
-		pool.topic = topic
+	if network != nil {
+		// subscribe to the gossip protocols
+		if err := pool.setupGossip(network); err != nil {
+			return nil, err
+		}
 	}
 
 	if grpcServer != nil {
@@ -282,12 +361,136 @@ func (p *TxPool) Start() {
 			}
 		}
 	}()
+
+	if p.journal != nil {
+		p.startJournal()
+	}
 }
 
 // Close shuts down the pool's main loop.
 func (p *TxPool) Close() {
 	p.eventManager.Close()
 	close(p.shutdownCh)
+
+	if p.gossipStream != nil {
+		if err := p.gossipStream.Close(); err != nil {
+			p.logger.Error("failed to close gossip stream", "err", err)
+		}
+	}
+
+	if p.journal != nil {
+		p.journalLock.Lock()
+		defer p.journalLock.Unlock()
+
+		if err := p.journal.close(); err != nil {
+			p.logger.Error("failed to close transactions journal", "err", err)
+		}
+	}
+}
+
+// startJournal replays the journaled local transactions into the pool,
+// and regenerates the journal from the pool periodically
+func (p *TxPool) startJournal() {
+	loaded, rejected, err := p.journal.load(func(tx *types.Transaction) error {
+		return p.addTx(local, tx)
+	})
+	if err != nil {
+		p.logger.Error("failed to load transactions journal", "err", err)
+	}
+
+	p.logger.Info("loaded transactions journal", "transactions", loaded, "rejected", rejected)
+
+	p.rotateJournal()
+
+	go func() {
+		ticker := time.NewTicker(p.rejournalInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-p.shutdownCh:
+				return
+			case <-ticker.C:
+				p.rotateJournal()
+			}
+		}
+	}()
+}
+
+// rotateJournal regenerates the journal from the transactions of the local accounts in the pool
+func (p *TxPool) rotateJournal() {
+	txs := p.localTxs()
+
+	p.journalLock.Lock()
+	defer p.journalLock.Unlock()
+
+	// the journal is not reopened once the pool is closed
+	select {
+	case <-p.shutdownCh:
+		return
+	default:
+	}
+
+	if err := p.journal.rotate(txs); err != nil {
+		p.logger.Error("failed to rotate transactions journal", "err", err)
+
+		return
+	}
+
+	if p.logger.IsDebug() {
+		p.logger.Debug("rotated transactions journal", "transactions", len(txs))
+	}
+}
+
+// journalTx appends the local transaction to the journal
+func (p *TxPool) journalTx(tx *types.Transaction) {
+	if p.journal == nil {
+		return
+	}
+
+	p.journalLock.Lock()
+	defer p.journalLock.Unlock()
+
+	if err := p.journal.insert(tx); err != nil {
+		p.logger.Error("failed to journal local transaction", "hash", tx.Hash(), "err", err)
+	}
+}
+
+// localTxs returns the promoted and the enqueued transactions of the local accounts in the nonce order,
+// excluding the conditional transactions
+func (p *TxPool) localTxs() []*types.Transaction {
+	var txs []*types.Transaction
+
+	for _, addr := range p.locals.list() {
+		account := p.accounts.get(addr)
+		if account == nil {
+			continue
+		}
+
+		account.promoted.lock(false)
+		account.enqueued.lock(false)
+
+		accountTxs := make([]*types.Transaction, 0, account.promoted.length()+account.enqueued.length())
+		accountTxs = append(accountTxs, account.promoted.queue...)
+		accountTxs = append(accountTxs, account.enqueued.queue...)
+
+		account.enqueued.unlock()
+		account.promoted.unlock()
+
+		// the queues are heaps, which are not fully sorted
+		sort.Slice(accountTxs, func(i, j int) bool {
+			return accountTxs[i].Nonce() < accountTxs[j].Nonce()
+		})
+
+		// the conditional transactions can't be replayed without their conditions
+		for _, tx := range accountTxs {
+			if p.conditionals.get(tx.Hash()) == nil {
+				txs = append(txs, tx)
+			}
+		}
+	}
+
+	return txs
 }
 
 // SetSigner sets the signer the pool will use
@@ -310,19 +513,11 @@ func (p *TxPool) AddTx(tx *types.Transaction) error {
 		return err
 	}
 
+	p.journalTx(tx)
+
 	// broadcast the transaction only if a topic
 	// subscription is present
-	if p.topic != nil {
-		tx := &proto.Txn{
-			Raw: &any.Any{
-				Value: tx.MarshalRLP(),
-			},
-		}
-
-		if err := p.topic.Publish(tx); err != nil {
-			p.logger.Error("failed to topic tx", "err", err)
-		}
-	}
+	p.broadcastTxs(tx)
 
 	return nil
 }
@@ -333,12 +528,35 @@ func (p *TxPool) Prepare() {
 	// fetch primary from each account
 	primaries := p.accounts.getPrimaries()
 
-	// create new executables queue with base fee and initial transactions (primaries)
-	p.executables = newPricesQueue(p.GetBaseFee(), primaries)
+	// separate the primaries of the priority accounts
+	var priorityPrimaries, otherPrimaries []*types.Transaction
+
+	for _, tx := range primaries {
+		if p.priority.contains(tx.From()) {
+			priorityPrimaries = append(priorityPrimaries, tx)
+		} else {
+			otherPrimaries = append(otherPrimaries, tx)
+		}
+	}
+
+	env := &OrderingEnv{
+		BaseFee: new(big.Int).SetUint64(p.GetBaseFee()),
+		Arrival: p.index.arrival,
+	}
+
+	// create new executables queues ordered by the policy with initial transactions (primaries)
+	p.priorityExecutables = newOrderedQueue(p.ordering, env, priorityPrimaries)
+	p.executables = newOrderedQueue(p.ordering, env, otherPrimaries)
+}
+
+// SetOrderingPolicy sets the order in which the executable transactions are served
+func (p *TxPool) SetOrderingPolicy(ordering OrderingPolicy) {
+	p.ordering = ordering
 }
 
 // Peek returns the best-price selected
 // transaction ready for execution.
+// The transactions of the priority accounts are returned first.
 func (p *TxPool) Peek() *types.Transaction {
 	// Popping the executables queue
 	// does not remove the actual tx
@@ -346,6 +564,10 @@ func (p *TxPool) Peek() *types.Transaction {
 	// The executables queue just provides
 	// insight into which account has the
 	// highest priced tx (head of promoted queue)
+	if tx := p.priorityExecutables.pop(); tx != nil {
+		return tx
+	}
+
 	return p.executables.pop()
 }
 
@@ -365,6 +587,11 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 		account.promoted.unlock()
 	}()
 
+	// the transaction could have been evicted after the executables were prepared
+	if first := account.promoted.peek(); first == nil || first.Nonce() != tx.Nonce() {
+		return
+	}
+
 	// pop the top most promoted tx
 	account.promoted.pop()
 
@@ -382,7 +609,11 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 
 	// update executables
 	if tx := account.promoted.peek(); tx != nil {
-		p.executables.push(tx)
+		if p.priority.contains(tx.From()) {
+			p.priorityExecutables.push(tx)
+		} else {
+			p.executables.push(tx)
+		}
 	}
 }
 
@@ -390,13 +621,14 @@ func (p *TxPool) Pop(tx *types.Transaction) {
 // and reverts its next (expected) nonce.
 func (p *TxPool) Drop(tx *types.Transaction) {
 	account := p.accounts.get(tx.From())
-	p.dropAccount(account, tx.Nonce(), tx)
+	p.dropAccount(account, tx.Nonce(), tx, reasonExecutionFailed)
 }
 
 // dropAccount clears all promoted and enqueued tx from the account
 // signals EventType_DROPPED for provided hash, clears all the slots and metrics
-// and sets nonce to provided nonce
-func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Transaction) {
+// and sets nonce to provided nonce. The drop reason is recorded in the lifecycle
+// of all the dropped txs. Returns the number of dropped txs
+func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Transaction, reason string) int {
 	account.promoted.lock(true)
 	account.enqueued.lock(true)
 	account.nonceToTx.lock()
@@ -414,6 +646,7 @@ func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Trans
 	clearAccountQueue := func(txs []*types.Transaction) {
 		p.index.remove(txs...)
 		p.gauge.decrease(slotsRequired(txs...))
+		p.lifecycles.record(proto.EventType_DROPPED, reason, toHash(txs...)...)
 
 		// increase counter
 		droppedCount += len(txs)
@@ -445,6 +678,8 @@ func (p *TxPool) dropAccount(account *account, nextNonce uint64, tx *types.Trans
 			"address", tx.From().String(),
 		)
 	}
+
+	return droppedCount
 }
 
 // Demote excludes an account from being further processed during block building
@@ -460,7 +695,7 @@ func (p *TxPool) Demote(tx *types.Transaction) {
 			)
 		}
 
-		p.Drop(tx)
+		p.dropAccount(account, tx.Nonce(), tx, reasonTooManyDemotes)
 
 		// reset the demotions counter
 		account.resetDemotions()
@@ -470,7 +705,7 @@ func (p *TxPool) Demote(tx *types.Transaction) {
 
 	account.incrementDemotions()
 
-	p.eventManager.signalEvent(proto.EventType_DEMOTED, tx.Hash())
+	p.signalEvent(proto.EventType_DEMOTED, "", tx.Hash())
 }
 
 // ResetWithHeaders processes the transactions from the new
@@ -531,14 +766,22 @@ func (p *TxPool) processEvent(event *blockchain.Event) {
 		}
 	}
 
-	// update base fee
+	// update base fee and drop the bundles which targeted the new blocks
 	if ln := len(event.NewChain); ln > 0 {
 		p.SetBaseFee(event.NewChain[ln-1])
+		p.bundles.prune(event.NewChain[ln-1].Number)
 	}
 
+	// forget the idle senders and peers
+	p.senderLimiter.prune()
+	p.peerLimiter.prune()
+
 	// reset accounts with the new state
 	p.resetAccounts(stateNonces)
 
+	// recheck the conditional transactions against the new state
+	p.pruneConditionals()
+
 	if !p.sealing.Load() {
 		// only non-validator cleanup inactive accounts
 		p.updateAccountSkipsCounts(stateNonces)
@@ -612,6 +855,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	stateRoot := currentHeader.StateRoot
 	latestBlockGasLimit := currentHeader.GasLimit
 	baseFee := p.GetBaseFee() // base fee is calculated for the next block
+	isPriority := p.priority.contains(from)
 
 	if tx.Type() == types.AccessListTxType {
 		// Reject access list tx if berlin hardfork(eip-2930) is not enabled
@@ -622,7 +866,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		}
 
 		// check if the given tx is not underpriced (same as Legacy approach)
-		if tx.GetGasPrice(p.GetBaseFee()).Cmp(big.NewInt(0).SetUint64(p.priceLimit)) < 0 {
+		if !isPriority && tx.GetGasPrice(p.GetBaseFee()).Cmp(big.NewInt(0).SetUint64(p.priceLimit)) < 0 {
 			metrics.IncrCounter([]string{txPoolMetrics, "underpriced_tx"}, 1)
 
 			return ErrUnderpriced
@@ -675,7 +919,8 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 		}
 	}
 
-	if tx.GetGasPrice(baseFee).Cmp(new(big.Int).SetUint64(p.priceLimit)) < 0 {
+	// the priority accounts are exempt from the price limit, but not from the base fee
+	if !isPriority && tx.GetGasPrice(baseFee).Cmp(new(big.Int).SetUint64(p.priceLimit)) < 0 {
 		// Make sure that the transaction is not underpriced
 		metrics.IncrCounter([]string{txPoolMetrics, "underpriced_tx"}, 1)
 
@@ -704,7 +949,7 @@ func (p *TxPool) validateTx(tx *types.Transaction) error {
 	}
 
 	// Make sure the transaction has more gas than the basic transaction fee
-	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul)
+	intrinsicGas, err := state.TransactionGasCost(tx, forks.Homestead, forks.Istanbul, forks.Shanghai)
 	if err != nil {
 		metrics.IncrCounter([]string{txPoolMetrics, "invalid_intrinsic_gas_tx"}, 1)
 
@@ -787,9 +1032,22 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 	// calculate tx hash
 	tx.ComputeHash()
 
+	// the known transactions are rejected later on, so they don't spend the sender tokens.
+	// The local transactions (including the ones replayed from the journal) are not rate limited
+	if _, known := p.index.get(tx.Hash()); !known && origin != local {
+		if err := p.allowSender(tx.From()); err != nil {
+			return err
+		}
+	}
+
 	// initialize account for this address once or retrieve existing one
 	account := p.getOrCreateAccount(tx.From())
 
+	// evict the cheaper remote transactions if the pool is full
+	if err := p.makeRoom(account, tx); err != nil {
+		return err
+	}
+
 	account.promoted.lock(true)
 	account.enqueued.lock(true)
 	account.nonceToTx.lock()
@@ -802,17 +1060,6 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 
 	accountNonce := account.getNonce()
 
-	//	only accept transactions with expected nonce
-	if p.gauge.highPressure() {
-		p.signalPruning()
-
-		if tx.Nonce() > accountNonce {
-			metrics.IncrCounter([]string{txPoolMetrics, "rejected_future_tx"}, 1)
-
-			return ErrRejectFutureTx
-		}
-	}
-
 	// try to find if there is transaction with same nonce for this account
 	oldTxWithSameNonce := account.nonceToTx.get(tx.Nonce())
 	if oldTxWithSameNonce != nil {
@@ -828,7 +1075,8 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 			return ErrReplacementUnderpriced
 		}
 	} else {
-		if account.enqueued.length() == account.maxEnqueued && tx.Nonce() != accountNonce {
+		if account.enqueued.length() == account.maxEnqueued && tx.Nonce() != accountNonce &&
+			!p.priority.contains(tx.From()) {
 			return ErrMaxEnqueuedLimitReached
 		}
 
@@ -872,25 +1120,30 @@ func (p *TxPool) addTx(origin txOrigin, tx *types.Transaction) error {
 
 	if oldTxWithSameNonce != nil {
 		p.index.remove(oldTxWithSameNonce)
+		p.lifecycles.record(proto.EventType_DROPPED, reasonReplaced, oldTxWithSameNonce.Hash())
 	} else {
 		metrics.SetGauge([]string{txPoolMetrics, "added_tx"}, 1)
 	}
 
 	account.enqueue(tx, oldTxWithSameNonce != nil) // add or replace tx into account
 
+	if origin == local {
+		p.locals.add(tx.From())
+	}
+
 	go p.invokePromotion(tx, tx.Nonce() <= accountNonce) // don't signal promotion for higher nonce txs
 
 	return nil
 }
 
 func (p *TxPool) invokePromotion(tx *types.Transaction, callPromote bool) {
-	p.eventManager.signalEvent(proto.EventType_ADDED, tx.Hash())
+	p.signalEvent(proto.EventType_ADDED, "", tx.Hash())
 
 	if p.logger.IsDebug() {
 		p.logger.Debug("enqueue request", "hash", tx.Hash().String())
 	}
 
-	p.eventManager.signalEvent(proto.EventType_ENQUEUED, tx.Hash())
+	p.signalEvent(proto.EventType_ENQUEUED, "", tx.Hash())
 
 	if callPromote {
 		select {
@@ -915,11 +1168,12 @@ func (p *TxPool) handlePromoteRequest(req promoteRequest) {
 
 	p.index.remove(pruned...)
 	p.gauge.decrease(slotsRequired(pruned...))
+	p.lifecycles.record(proto.EventType_PRUNED_ENQUEUED, reasonNonceUsed, toHash(pruned...)...)
 
 	// update metrics
 	p.updatePending(int64(len(promoted)))
 
-	p.eventManager.signalEvent(proto.EventType_PROMOTED, toHash(promoted...)...)
+	p.signalEvent(proto.EventType_PROMOTED, "", toHash(promoted...)...)
 }
 
 // addGossipTx handles receiving transactions
@@ -952,18 +1206,38 @@ func (p *TxPool) addGossipTx(obj interface{}, peerID peer.ID) {
 		return
 	}
 
-	// add tx
+	// the known transactions don't spend the peer tokens, since they are gossiped by many peers
+	if _, known := p.index.get(tx.ComputeHash().Hash()); !known {
+		if err := p.allowPeer(peerID); err != nil {
+			if p.logger.IsDebug() {
+				p.logger.Debug("rejecting tx (gossip)", "err", err, "peer", peerID, "hash", tx.Hash().String())
+			}
+
+			return
+		}
+	}
+
+	p.addRemoteTx(tx)
+}
+
+// addRemoteTx adds the transaction received from the network,
+// and returns true if it has been accepted by the pool
+func (p *TxPool) addRemoteTx(tx *types.Transaction) bool {
 	if err := p.addTx(gossip, tx); err != nil {
 		if errors.Is(err, ErrAlreadyKnown) {
 			if p.logger.IsDebug() {
 				p.logger.Debug("rejecting known tx (gossip)", "hash", tx.Hash().String())
 			}
 
-			return
+			return false
 		}
 
 		p.logger.Error("failed to add broadcast tx", "err", err, "hash", tx.Hash().String())
+
+		return false
 	}
+
+	return true
 }
 
 // resetAccounts updates existing accounts with the new nonce and prunes stale transactions.
@@ -1006,8 +1280,9 @@ func (p *TxPool) resetAccounts(stateNonces map[types.Address]uint64) {
 	if len(allPrunedPromoted) > 0 {
 		cleanup(allPrunedPromoted)
 
-		p.eventManager.signalEvent(
+		p.signalEvent(
 			proto.EventType_PRUNED_PROMOTED,
+			reasonNonceUsed,
 			toHash(allPrunedPromoted...)...,
 		)
 
@@ -1017,8 +1292,9 @@ func (p *TxPool) resetAccounts(stateNonces map[types.Address]uint64) {
 	if len(allPrunedEnqueued) > 0 {
 		cleanup(allPrunedEnqueued)
 
-		p.eventManager.signalEvent(
+		p.signalEvent(
 			proto.EventType_PRUNED_ENQUEUED,
+			reasonNonceUsed,
 			toHash(allPrunedEnqueued...)...,
 		)
 	}
@@ -1052,7 +1328,7 @@ func (p *TxPool) updateAccountSkipsCounts(latestActiveAccounts map[types.Address
 
 			// account has been skipped too many times
 			nextNonce := p.store.GetNonce(stateRoot, firstTx.From())
-			p.dropAccount(account, nextNonce, firstTx)
+			p.dropAccount(account, nextNonce, firstTx, reasonTooManySkips)
 
 			account.resetSkips()
 
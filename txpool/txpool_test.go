@@ -46,10 +46,11 @@ var (
 
 // returns a new valid tx of slots size with the given nonce
 func newTx(addr types.Address, nonce, slots uint64, txType types.TxType) *types.Transaction {
-	// base field should take 1 slot at least
+	// base field should take 1 slot at least,
+	// the random input is long enough so the hashes of the different accounts' txs don't collide
 	size := txSlotSize * (slots - 1)
 	if size <= 0 {
-		size = 1
+		size = types.HashLength
 	}
 
 	input := make([]byte, size)
@@ -2228,6 +2229,29 @@ func Test_TxPool_validateTx(t *testing.T) {
 		)
 	})
 
+	t.Run("tx gas not covering the init code words", func(t *testing.T) {
+		t.Parallel()
+		pool := setupPool()
+		pool.forks = chain.AllForksEnabled.Copy()
+
+		input := make([]byte, 64)
+
+		newCreation := func(gas uint64) *types.Transaction {
+			tx := newTx(defaultAddr, 0, 1, types.LegacyTxType)
+			tx.SetTo(nil)
+			tx.SetInput(input)
+			tx.SetGas(gas)
+			tx.SetGasPrice(new(big.Int).SetUint64(pool.GetBaseFee()))
+
+			return signTx(tx)
+		}
+
+		gas := state.TxGasContractCreation + uint64(len(input))*4
+
+		assert.ErrorIs(t, pool.validateTx(newCreation(gas)), ErrIntrinsicGas)
+		assert.NoError(t, pool.validateTx(newCreation(gas+2*runtime.InitCodeWordGas)))
+	})
+
 	t.Run("transaction with eip-1559 fields can pass", func(t *testing.T) {
 		t.Parallel()
 
@@ -3760,6 +3784,84 @@ func TestAddTx_TxReplacement(t *testing.T) {
 	assert.Equal(t, ac2.enqueued.queue[0], tx1)
 }
 
+func TestPriorityAccounts(t *testing.T) {
+	t.Parallel()
+
+	newPriorityTestPool := func(t *testing.T, maxSlots uint64) *TxPool {
+		t.Helper()
+
+		pool := newEvictionTestPool(t, maxSlots)
+		pool.priority.add(addr1)
+
+		return pool
+	}
+
+	t.Run("exempt from the price limit", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPriorityTestPool(t, defaultMaxSlots)
+		pool.priceLimit = 10
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, 0, 1)))
+		require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr2, 0, 1)), ErrUnderpriced)
+	})
+
+	t.Run("exempt from the enqueued limit", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPriorityTestPool(t, defaultMaxSlots)
+		pool.accounts.maxEnqueuedLimit = 1
+
+		for nonce := uint64(1); nonce <= 3; nonce++ {
+			require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, nonce, 1)))
+		}
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 1, 1)))
+		require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr2, 2, 1)), ErrMaxEnqueuedLimitReached)
+
+		require.Equal(t, uint64(3), pool.accounts.get(addr1).enqueued.length())
+	})
+
+	t.Run("never evicted", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPriorityTestPool(t, 2)
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, 0, 1)))
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, 0, 2)))
+
+		require.NoError(t, pool.addTx(gossip, newPricedTx(addr3, 0, 10)))
+		require.ErrorIs(t, pool.addTx(gossip, newPricedTx(addr4, 0, 10)), ErrTxPoolOverflow)
+
+		require.Equal(t, uint64(1), pool.accounts.get(addr1).enqueued.length())
+	})
+
+	t.Run("executed before the others", func(t *testing.T) {
+		t.Parallel()
+
+		pool := newPriorityTestPool(t, defaultMaxSlots)
+
+		for nonce := uint64(0); nonce < 2; nonce++ {
+			require.NoError(t, pool.addTx(gossip, newPricedTx(addr1, nonce, 1)))
+			require.NoError(t, pool.addTx(gossip, newPricedTx(addr2, nonce, 10)))
+		}
+
+		pool.handlePromoteRequest(promoteRequest{account: addr1})
+		pool.handlePromoteRequest(promoteRequest{account: addr2})
+
+		pool.Prepare()
+
+		var senders []types.Address
+
+		for tx := pool.Peek(); tx != nil; tx = pool.Peek() {
+			pool.Pop(tx)
+			senders = append(senders, tx.From())
+		}
+
+		require.Equal(t, []types.Address{addr1, addr1, addr2, addr2}, senders)
+	})
+}
+
 // getDefaultEnabledForks returns hardcoded set of forks
 // that are enabled by default from the genesis block
 func getDefaultEnabledForks() *chain.Forks {
@@ -10,8 +10,8 @@ import (
 type TxAccessList []AccessTuple
 
 type AccessTuple struct {
-	Address     Address
-	StorageKeys []Hash
+	Address     Address `json:"address"`
+	StorageKeys []Hash  `json:"storageKeys"`
 }
 
 // StorageKeys returns the total number of storage keys in the access list.
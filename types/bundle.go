@@ -0,0 +1,49 @@
+package types
+
+import (
+	"encoding/binary"
+
+	"github.com/0xPolygon/polygon-edge/helper/keccak"
+)
+
+// Bundle is a group of transactions which is included in a block atomically,
+// i.e. the transactions are executed in the given order and included only if all of them succeed
+type Bundle struct {
+	Txs []*Transaction
+
+	// BlockNumber is the number of the only block the bundle can be included in
+	BlockNumber uint64
+
+	// MinTimestamp and MaxTimestamp bound the timestamp of the block, zero means no bound
+	MinTimestamp uint64
+	MaxTimestamp uint64
+}
+
+// Hash returns the keccak256 hash of the concatenated hashes of the bundle transactions,
+// followed by the big endian encoded target block number and timestamp bounds.
+// The transactions hashes have to be computed beforehand
+func (b *Bundle) Hash() Hash {
+	data := make([]byte, 0, len(b.Txs)*HashLength+3*8)
+	for _, tx := range b.Txs {
+		data = append(data, tx.Hash().Bytes()...)
+	}
+
+	data = binary.BigEndian.AppendUint64(data, b.BlockNumber)
+	data = binary.BigEndian.AppendUint64(data, b.MinTimestamp)
+	data = binary.BigEndian.AppendUint64(data, b.MaxTimestamp)
+
+	return BytesToHash(keccak.Keccak256(nil, data))
+}
+
+// IncludableIn returns true if the bundle targets the block with the given number and timestamp
+func (b *Bundle) IncludableIn(number, timestamp uint64) bool {
+	if b.BlockNumber != number {
+		return false
+	}
+
+	if b.MinTimestamp != 0 && timestamp < b.MinTimestamp {
+		return false
+	}
+
+	return b.MaxTimestamp == 0 || timestamp <= b.MaxTimestamp
+}
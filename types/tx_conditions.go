@@ -0,0 +1,62 @@
+package types
+
+import (
+	"errors"
+	"fmt"
+)
+
+var (
+	ErrTxConditionsPremature = errors.New("transaction conditions block range is not reached yet")
+	ErrTxConditionsExpired   = errors.New("transaction conditions block range has passed")
+	ErrTxConditionsStorage   = errors.New("transaction conditions storage mismatch")
+)
+
+// TxConditions are the conditions of the chain state a transaction can be included under
+// (see eth_sendRawTransactionConditional)
+type TxConditions struct {
+	// KnownAccounts are the expected values of the accounts storage slots
+	KnownAccounts map[Address]map[Hash]Hash
+
+	// BlockNumberMin and BlockNumberMax bound the number of the block, zero means no bound
+	BlockNumberMin uint64
+	BlockNumberMax uint64
+
+	// TimestampMin and TimestampMax bound the timestamp of the block, zero means no bound
+	TimestampMin uint64
+	TimestampMax uint64
+}
+
+// SlotsCount returns the number of the storage slots the conditions expect values of
+func (c *TxConditions) SlotsCount() int {
+	count := 0
+	for _, slots := range c.KnownAccounts {
+		count += len(slots)
+	}
+
+	return count
+}
+
+// Check returns an error if the block with the given number and timestamp doesn't satisfy the conditions.
+// The getStorage returns the storage slot value of an account in the state the block is built on.
+// ErrTxConditionsPremature is only returned if the rest of the conditions hold
+func (c *TxConditions) Check(number, timestamp uint64, getStorage func(Address, Hash) Hash) error {
+	if (c.BlockNumberMax != 0 && number > c.BlockNumberMax) ||
+		(c.TimestampMax != 0 && timestamp > c.TimestampMax) {
+		return ErrTxConditionsExpired
+	}
+
+	for addr, slots := range c.KnownAccounts {
+		for slot, expected := range slots {
+			if value := getStorage(addr, slot); value != expected {
+				return fmt.Errorf("%w: account %s slot %s has value %s, expected %s",
+					ErrTxConditionsStorage, addr, slot, value, expected)
+			}
+		}
+	}
+
+	if number < c.BlockNumberMin || timestamp < c.TimestampMin {
+		return ErrTxConditionsPremature
+	}
+
+	return nil
+}
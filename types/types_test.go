@@ -137,3 +137,23 @@ func TestIsValidAddress(t *testing.T) {
 		}
 	}
 }
+
+func TestBundleHash(t *testing.T) {
+	t.Parallel()
+
+	tx := NewTx(&LegacyTx{GasPrice: big.NewInt(1), BaseTx: &BaseTx{Gas: 21000}}).ComputeHash()
+
+	bundle := &Bundle{Txs: []*Transaction{tx}, BlockNumber: 1}
+	hash := bundle.Hash()
+
+	// the bundles of the same transactions with the different targets are different bundles
+	for _, other := range []*Bundle{
+		{Txs: []*Transaction{tx}, BlockNumber: 2},
+		{Txs: []*Transaction{tx}, BlockNumber: 1, MinTimestamp: 10},
+		{Txs: []*Transaction{tx}, BlockNumber: 1, MaxTimestamp: 10},
+	} {
+		require.NotEqual(t, hash, other.Hash())
+	}
+
+	require.Equal(t, hash, (&Bundle{Txs: []*Transaction{tx}, BlockNumber: 1}).Hash())
+}